@@ -10,6 +10,8 @@ func (BaseVisitor) IntegerNode(node *IntegerNode) {}
 
 func (BaseVisitor) FloatNode(node *FloatNode) {}
 
+func (BaseVisitor) DurationNode(node *DurationNode) {}
+
 func (BaseVisitor) BoolNode(node *BoolNode) {}
 
 func (BaseVisitor) StringNode(node *StringNode) {}
@@ -41,3 +43,7 @@ func (BaseVisitor) ArrayNode(node *ArrayNode) {}
 func (BaseVisitor) MapNode(node *MapNode) {}
 
 func (BaseVisitor) PairNode(node *PairNode) {}
+
+func (BaseVisitor) LetNode(node *LetNode) {}
+
+func (BaseVisitor) ConstantNode(node *ConstantNode) {}
@@ -1,33 +1,34 @@
 package ast
 
 import (
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/file"
 	"reflect"
 	"regexp"
+	"time"
 )
 
 // Node represents items of abstract syntax tree.
 type Node interface {
-	GetLocation() helper.Location
-	SetLocation(helper.Location)
+	GetLocation() file.Location
+	SetLocation(file.Location)
 	GetType() reflect.Type
 	SetType(reflect.Type)
 }
 
 type NilNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 }
 
 type IdentifierNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Value string
 }
 
 type IntegerNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Value   int
@@ -35,28 +36,36 @@ type IntegerNode struct {
 }
 
 type FloatNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Value float64
 }
 
+// DurationNode is a duration literal, like 3h30m.
+type DurationNode struct {
+	l file.Location
+	t reflect.Type
+
+	Value time.Duration
+}
+
 type BoolNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Value bool
 }
 
 type StringNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Value string
 }
 
 type UnaryNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Operator string
@@ -64,7 +73,7 @@ type UnaryNode struct {
 }
 
 type BinaryNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Operator string
@@ -73,7 +82,7 @@ type BinaryNode struct {
 }
 
 type MatchesNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Regexp *regexp.Regexp
@@ -82,15 +91,18 @@ type MatchesNode struct {
 }
 
 type PropertyNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Node     Node
 	Property string
+	// NilSafe is set for ?. accessor, which results in nil
+	// instead of an error if node is nil.
+	NilSafe bool
 }
 
 type IndexNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Node  Node
@@ -98,16 +110,19 @@ type IndexNode struct {
 }
 
 type MethodNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Node      Node
 	Method    string
 	Arguments []Node
+	// NilSafe is set for ?. call, which results in nil
+	// instead of an error if node is nil.
+	NilSafe bool
 }
 
 type FunctionNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Name      string
@@ -115,7 +130,7 @@ type FunctionNode struct {
 }
 
 type BuiltinNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Name      string
@@ -123,19 +138,26 @@ type BuiltinNode struct {
 }
 
 type ClosureNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
-	Node Node
+	// Params are names of the element and optionally of its index,
+	// the element is accessible only with # if there are none.
+	Params []string
+	Node   Node
 }
 
 type PointerNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
+
+	// Name of #name pointer, which refers to a value bound by builtin
+	// instead of the current item.
+	Name string
 }
 
 type ConditionalNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Cond Node
@@ -144,23 +166,42 @@ type ConditionalNode struct {
 }
 
 type ArrayNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Nodes []Node
 }
 
 type MapNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Pairs []*PairNode
 }
 
 type PairNode struct {
-	l helper.Location
+	l file.Location
 	t reflect.Type
 
 	Key   Node
 	Value Node
 }
+
+// LetNode binds value to the name, which can be used within node.
+type LetNode struct {
+	l file.Location
+	t reflect.Type
+
+	Name  string
+	Value Node
+	Node  Node
+}
+
+// ConstantNode holds precomputed value, it is produced by the
+// optimizer, and by the parser only for integer literals out of int range.
+type ConstantNode struct {
+	l file.Location
+	t reflect.Type
+
+	Value interface{}
+}
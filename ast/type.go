@@ -34,6 +34,14 @@ func (n *FloatNode) GetType() reflect.Type {
 	return n.t
 }
 
+func (n *DurationNode) SetType(t reflect.Type) {
+	n.t = t
+}
+
+func (n *DurationNode) GetType() reflect.Type {
+	return n.t
+}
+
 func (n *BoolNode) SetType(t reflect.Type) {
 	n.t = t
 }
@@ -161,3 +169,19 @@ func (n *PairNode) GetType() reflect.Type {
 func (n *PairNode) SetType(t reflect.Type) {
 	n.t = t
 }
+
+func (n *LetNode) GetType() reflect.Type {
+	return n.t
+}
+
+func (n *LetNode) SetType(t reflect.Type) {
+	n.t = t
+}
+
+func (n *ConstantNode) GetType() reflect.Type {
+	return n.t
+}
+
+func (n *ConstantNode) SetType(t reflect.Type) {
+	n.t = t
+}
@@ -7,6 +7,7 @@ type Visitor interface {
 	IdentifierNode(node *IdentifierNode)
 	IntegerNode(node *IntegerNode)
 	FloatNode(node *FloatNode)
+	DurationNode(node *DurationNode)
 	BoolNode(node *BoolNode)
 	StringNode(node *StringNode)
 	UnaryNode(node *UnaryNode)
@@ -23,6 +24,8 @@ type Visitor interface {
 	ArrayNode(node *ArrayNode)
 	MapNode(node *MapNode)
 	PairNode(node *PairNode)
+	LetNode(node *LetNode)
+	ConstantNode(node *ConstantNode)
 }
 
 type walker struct {
@@ -46,6 +49,8 @@ func (w *walker) walk(node Node) {
 		w.visitor.IntegerNode(n)
 	case *FloatNode:
 		w.visitor.FloatNode(n)
+	case *DurationNode:
+		w.visitor.DurationNode(n)
 	case *BoolNode:
 		w.visitor.BoolNode(n)
 	case *StringNode:
@@ -107,6 +112,12 @@ func (w *walker) walk(node Node) {
 	case *PairNode:
 		w.walk(n.Value)
 		w.visitor.PairNode(n)
+	case *LetNode:
+		w.walk(n.Value)
+		w.walk(n.Node)
+		w.visitor.LetNode(n)
+	case *ConstantNode:
+		w.visitor.ConstantNode(n)
 	default:
 		panic(fmt.Sprintf("undefined node type (%T)", node))
 	}
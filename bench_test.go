@@ -21,6 +21,7 @@ func Benchmark_expr(b *testing.B) {
 
 	var out interface{}
 
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		out, err = vm.Run(program, params, nil)
 	}
@@ -42,6 +43,7 @@ func Benchmark_filter(b *testing.B) {
 		b.Fatal(err)
 	}
 
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		_, err = vm.Run(program, params, nil)
 	}
@@ -66,6 +68,7 @@ func Benchmark_access(b *testing.B) {
 
 	env := Env{Price: Price{Value: 1}}
 
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		_, err = vm.Run(program, env, nil)
 	}
@@ -74,3 +77,79 @@ func Benchmark_access(b *testing.B) {
 		b.Fatal(err)
 	}
 }
+
+func Benchmark_newVM(b *testing.B) {
+	params := make(map[string]interface{})
+	params["Origin"] = "MOW"
+	params["Country"] = "RU"
+	params["Adults"] = int64(1)
+	params["Value"] = int64(100)
+
+	program, err := expr.Compile(`(Origin == "MOW" || Country == "RU") && (Value >= 100 || Adults == 1)`, expr.Env(params))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var out interface{}
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		out, err = vm.NewVM(false, nil).RunProgram(program, params)
+	}
+
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !out.(bool) {
+		b.Fail()
+	}
+}
+
+func Benchmark_reuseVM(b *testing.B) {
+	params := make(map[string]interface{})
+	params["Origin"] = "MOW"
+	params["Country"] = "RU"
+	params["Adults"] = int64(1)
+	params["Value"] = int64(100)
+
+	program, err := expr.Compile(`(Origin == "MOW" || Country == "RU") && (Value >= 100 || Adults == 1)`, expr.Env(params))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var out interface{}
+	v := vm.NewVM(false, nil)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		out, err = v.RunProgram(program, params)
+	}
+
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !out.(bool) {
+		b.Fail()
+	}
+}
+
+func Benchmark_reuseVM_filter(b *testing.B) {
+	params := make(map[string]interface{})
+	params["max"] = 50
+
+	program, err := expr.Compile(`filter(1..100, {# > max})`, expr.Env(params))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	v := vm.NewVM(false, nil)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, err = v.RunProgram(program, params)
+	}
+
+	if err != nil {
+		b.Fatal(err)
+	}
+}
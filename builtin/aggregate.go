@@ -0,0 +1,274 @@
+package builtin
+
+import (
+	"reflect"
+
+	"github.com/jakub-gawlas/expr/ast"
+	. "github.com/jakub-gawlas/expr/vm"
+)
+
+func init() {
+	Register(&Builtin{
+		Name:    "count",
+		Check:   checkPredicate(integerType),
+		Compile: compileCount,
+	})
+	Register(&Builtin{
+		Name:    "sum",
+		Check:   checkAggregate(isNumber, "numbers", nil),
+		Compile: compileSum,
+	})
+	Register(&Builtin{
+		Name:    "avg",
+		Check:   checkAggregate(isNumber, "numbers", floatType),
+		Compile: compileAvg,
+	})
+	Register(&Builtin{
+		Name:    "min",
+		Check:   checkExtremum,
+		Compile: compileExtremum(OpLess),
+	})
+	Register(&Builtin{
+		Name:    "max",
+		Check:   checkExtremum,
+		Compile: compileExtremum(OpMore),
+	})
+	Register(&Builtin{
+		Name:    "reduce",
+		Check:   checkReduce,
+		Compile: compileReduce,
+	})
+}
+
+// checkAggregate checks builtin taking an array and optionally a closure
+// mapping its items to values, which are aggregated. It returns the result
+// type, or the type of values if the result is nil, so int stays int.
+func checkAggregate(valid func(reflect.Type) bool, values string, result reflect.Type) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, 1, 2) {
+			return interfaceType
+		}
+		collection := c.Visit(node.Arguments[0])
+		t := elemType(collection)
+		if len(node.Arguments) > 1 {
+			t = c.VisitClosure(node.Arguments[1], collection)
+		}
+
+		if !isArray(collection) {
+			return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+		}
+		if !valid(t) {
+			if len(node.Arguments) > 1 {
+				return c.Error(node.Arguments[1], "closure should return %v (got %v)", values, t)
+			}
+			return c.Error(node.Arguments[0], "builtin %v takes only array of %v (got %v)", node.Name, values, collection)
+		}
+		if result == nil {
+			return t
+		}
+		return result
+	}
+}
+
+// checkExtremum checks min or max of an array, or of scalars if the
+// builtin is called with them.
+func checkExtremum(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if isScalarCall(node) {
+		return checkScalars(c, node, isOrdered, "numbers or strings")
+	}
+	return checkAggregate(isOrdered, "numbers or strings", nil)(c, node)
+}
+
+// isScalarCall reports if min or max is called with scalars, like min(a, b),
+// instead of an array and an optional closure, like min(xs, {.Price}).
+func isScalarCall(node *ast.BuiltinNode) bool {
+	if len(node.Arguments) < 2 {
+		return false
+	}
+	_, closure := node.Arguments[1].(*ast.ClosureNode)
+	return !closure
+}
+
+func checkReduce(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 2, 3) {
+		return interfaceType
+	}
+	collection := c.Visit(node.Arguments[0])
+	acc := elemType(collection)
+	if len(node.Arguments) > 2 {
+		acc = c.Visit(node.Arguments[2])
+	}
+
+	var t reflect.Type
+	c.Bind("acc", acc, func() {
+		t = c.VisitClosure(node.Arguments[1], collection)
+	})
+
+	if !isArray(collection) {
+		return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+	}
+	return t
+}
+
+// emitValue emits value of the current item, which is the item itself
+// or the result of the closure passed as the second argument.
+func emitValue(c Compiler, node *ast.BuiltinNode) {
+	if len(node.Arguments) > 1 {
+		c.Compile(node.Arguments[1])
+		return
+	}
+	c.EmitItem()
+}
+
+// emitFold emits bytecode storing the value emitted by first in the acc slot
+// for the first item of the loop, and the value emitted by next for the rest.
+func emitFold(c Compiler, acc []byte, first, next func()) {
+	c.EmitIndex()
+	c.Emit(OpPush, 0, 0)
+	c.Emit(OpEqual)
+	otherwise := c.Emit(OpJumpIfFalse, c.Placeholder()...)
+	c.Emit(OpPop)
+	first()
+	end := c.Emit(OpJump, c.Placeholder()...)
+	c.PatchJump(otherwise)
+	c.Emit(OpPop)
+	next()
+	c.PatchJump(end)
+	c.Emit(OpStoreSlot, acc...)
+}
+
+// emitSum emits loop over the array on top of the stack storing sum of values
+// in the acc slot, and returns constant of the array size. The sum starts with
+// the first value to keep its type, and sum of empty array is 0.
+func emitSum(c Compiler, node *ast.BuiltinNode, acc []byte) []byte {
+	value := c.Slot()
+	c.Emit(OpPush, 0, 0)
+	c.Emit(OpStoreSlot, acc...)
+	c.Emit(OpBegin)
+	return c.EmitLoop(func() {
+		emitValue(c, node)
+		c.Emit(OpStoreSlot, value...)
+		emitFold(c, acc, func() {
+			c.Emit(OpLoadSlot, value...)
+		}, func() {
+			c.Emit(OpLoadSlot, acc...)
+			c.Emit(OpLoadSlot, value...)
+			c.Emit(OpAdd)
+		})
+	})
+}
+
+func compileSum(c Compiler, node *ast.BuiltinNode) {
+	acc := c.Slot()
+	c.Compile(node.Arguments[0])
+	emitSum(c, node, acc)
+	c.Emit(OpEnd)
+	c.Emit(OpLoadSlot, acc...)
+}
+
+// compileAvg emits sum converted to float divided by the array size,
+// average of empty array is an error.
+func compileAvg(c Compiler, node *ast.BuiltinNode) {
+	acc := c.Slot()
+	c.Compile(node.Arguments[0])
+	c.Emit(OpNotEmpty, c.Constant(node.Name)...)
+	size := emitSum(c, node, acc)
+	c.Emit(OpLoadSlot, acc...)
+	c.Emit(OpConst, c.Constant(float64(0))...)
+	c.Emit(OpAdd)
+	c.Emit(OpLoad, size...)
+	c.Emit(OpDivide)
+	c.Emit(OpEnd)
+}
+
+// compileExtremum emits bytecode keeping value, which is less or more than
+// the others depending on the comparison opcode. If the builtin is called
+// with scalars, they are compared. Otherwise, values of the array are
+// compared in a loop, and empty array is an error.
+func compileExtremum(compare byte) func(c Compiler, node *ast.BuiltinNode) {
+	return func(c Compiler, node *ast.BuiltinNode) {
+		acc := c.Slot()
+		value := c.Slot()
+		if isScalarCall(node) {
+			c.Compile(node.Arguments[0])
+			c.Emit(OpStoreSlot, acc...)
+			for _, arg := range node.Arguments[1:] {
+				c.Compile(arg)
+				c.Emit(OpStoreSlot, value...)
+				emitPick(c, compare, acc, value)
+				c.Emit(OpStoreSlot, acc...)
+			}
+			c.Emit(OpLoadSlot, acc...)
+			return
+		}
+
+		c.Emit(OpNil)
+		c.Emit(OpStoreSlot, acc...)
+		c.Compile(node.Arguments[0])
+		c.Emit(OpNotEmpty, c.Constant(node.Name)...)
+		c.Emit(OpBegin)
+		c.EmitLoop(func() {
+			emitValue(c, node)
+			c.Emit(OpStoreSlot, value...)
+			emitFold(c, acc, func() {
+				c.Emit(OpLoadSlot, value...)
+			}, func() {
+				emitPick(c, compare, acc, value)
+			})
+		})
+		c.Emit(OpEnd)
+		c.Emit(OpLoadSlot, acc...)
+	}
+}
+
+// emitPick emits bytecode pushing value of the value slot if the comparison
+// of it with value of the acc slot is true, and value of the acc slot otherwise.
+func emitPick(c Compiler, compare byte, acc, value []byte) {
+	c.Emit(OpLoadSlot, value...)
+	c.Emit(OpLoadSlot, acc...)
+	c.Emit(compare)
+	otherwise := c.Emit(OpJumpIfFalse, c.Placeholder()...)
+	c.Emit(OpPop)
+	c.Emit(OpLoadSlot, value...)
+	end := c.Emit(OpJump, c.Placeholder()...)
+	c.PatchJump(otherwise)
+	c.Emit(OpPop)
+	c.Emit(OpLoadSlot, acc...)
+	c.PatchJump(end)
+}
+
+// compileReduce emits loop storing result of the closure in the acc slot,
+// which is accessible within the closure with #acc. Without the initial
+// value, the first item is used, and result of empty array is nil.
+func compileReduce(c Compiler, node *ast.BuiltinNode) {
+	acc := c.Slot()
+	if len(node.Arguments) > 2 {
+		c.Compile(node.Arguments[2])
+	} else {
+		c.Emit(OpNil)
+	}
+	c.Emit(OpStoreSlot, acc...)
+
+	next := func() {
+		c.Bind("acc", acc, func() {
+			c.Compile(node.Arguments[1])
+		})
+	}
+
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	c.EmitLoop(func() {
+		if len(node.Arguments) > 2 {
+			next()
+			c.Emit(OpStoreSlot, acc...)
+			return
+		}
+		emitFold(c, acc, c.EmitItem, next)
+	})
+	c.Emit(OpEnd)
+	c.Emit(OpLoadSlot, acc...)
+}
+
+func isOrdered(t reflect.Type) bool {
+	return isNumber(t) || isString(t)
+}
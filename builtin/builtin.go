@@ -0,0 +1,129 @@
+// Package builtin is a registry of builtin functions.
+//
+// Builtins are called like functions of the env, but are resolved at
+// compile time and can take closures as arguments. Each builtin has a
+// type check hook, and is either compiled to bytecode by a compile hook
+// or calls a Go implementation at runtime.
+package builtin
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jakub-gawlas/expr/ast"
+	"github.com/jakub-gawlas/expr/vm"
+)
+
+// Builtin describes a builtin function.
+type Builtin struct {
+	Name string
+
+	// Check returns type of the builtin call, or reports an error with checker.Error.
+	Check func(c Checker, node *ast.BuiltinNode) reflect.Type
+
+	// Compile emits bytecode of the builtin call, which leaves result on the stack.
+	Compile func(c Compiler, node *ast.BuiltinNode)
+
+	// Func is called with values of arguments if Compile is not set.
+	// Closures are passed as func(...interface{}) interface{}, which must
+	// not be called after Func returns. Errors of Func are returned by Run
+	// as *vm.RuntimeError at location of the call.
+	Func vm.Func
+
+	// StringSize is set for Func building strings sized by its arguments,
+	// like repeat, so they are not built if they exceed vm.MaxStringSize.
+	StringSize vm.StringSize
+}
+
+// Checker type checks arguments of builtin calls.
+type Checker interface {
+	// Visit checks the node and returns its type.
+	Visit(node ast.Node) reflect.Type
+
+	// VisitClosure checks closure called for items of the collection
+	// and returns type of its result.
+	VisitClosure(node ast.Node, collection reflect.Type) reflect.Type
+
+	// Error reports an error at the node and returns interface type,
+	// so checking can continue.
+	Error(node ast.Node, format string, args ...interface{}) reflect.Type
+
+	// Bind makes value of the type accessible with #name within
+	// closures checked by the body.
+	Bind(name string, t reflect.Type, body func())
+
+	// Coerce sets type of numbers with integer literals of uncertain type,
+	// like 1 or 2 * 3, to type of the first number of certain type, as it is
+	// done for operands of binary operators. Types of the nodes are updated.
+	Coerce(nodes []ast.Node, types []reflect.Type)
+}
+
+// Compiler emits bytecode of builtin calls.
+type Compiler interface {
+	// Compile emits bytecode of the node, which leaves its value on the stack.
+	Compile(node ast.Node)
+
+	// Emit emits the opcode with its argument, and returns position of the argument.
+	Emit(op byte, arg ...byte) int
+
+	// Constant adds the value to constants of the program, and returns its index as an argument.
+	Constant(value interface{}) []byte
+
+	// Placeholder returns argument of a jump, which is patched later with PatchJump.
+	Placeholder() []byte
+
+	// PatchJump sets the jump at the position to the end of the emitted bytecode.
+	PatchJump(position int)
+
+	// Slot allocates a slot for a value stored with vm.OpStoreSlot.
+	Slot() []byte
+
+	// EmitLoop emits loop over the array on top of the stack, which must be
+	// surrounded with vm.OpBegin and vm.OpEnd. Closures compiled within the
+	// body are called for the current item. It returns constant of the array
+	// size, which can be loaded with vm.OpLoad.
+	EmitLoop(body func()) []byte
+
+	// EmitItem emits bytecode pushing the current item of the loop.
+	EmitItem()
+
+	// EmitIndex emits bytecode pushing index of the current item of the loop.
+	EmitIndex()
+
+	// Bind makes value stored in the slot accessible with #name within
+	// closures compiled by the body.
+	Bind(name string, slot []byte, body func())
+
+	// EmitCond emits the body executed if value on top of the stack is true,
+	// the value is popped in any case.
+	EmitCond(body func())
+}
+
+var builtins = make(map[string]*Builtin)
+
+// Register makes the builtin available to expressions. Functions of the env
+// shadow builtins with the same name. It must be called from init, as the
+// registry is not guarded by locks.
+func Register(b *Builtin) {
+	if b.Name == "" {
+		panic("builtin: name is empty")
+	}
+	if b.Check == nil {
+		panic(fmt.Sprintf("builtin: %v has no check hook", b.Name))
+	}
+	if b.Compile == nil && b.Func == nil {
+		panic(fmt.Sprintf("builtin: %v has neither compile hook nor func", b.Name))
+	}
+
+	builtins[b.Name] = b
+	if b.Compile == nil {
+		vm.RegisterFunc(b.Name, b.Func)
+		vm.RegisterStringSize(b.Name, b.StringSize)
+	}
+}
+
+// Lookup returns the builtin registered with the name.
+func Lookup(name string) (*Builtin, bool) {
+	b, ok := builtins[name]
+	return b, ok
+}
@@ -0,0 +1,180 @@
+package builtin_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jakub-gawlas/expr"
+	"github.com/jakub-gawlas/expr/ast"
+	"github.com/jakub-gawlas/expr/builtin"
+	"github.com/jakub-gawlas/expr/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	builtin.Register(&builtin.Builtin{
+		Name: "countIf",
+		Check: func(c builtin.Checker, node *ast.BuiltinNode) reflect.Type {
+			collection := c.Visit(node.Arguments[0])
+			c.VisitClosure(node.Arguments[1], collection)
+			return reflect.TypeOf(0)
+		},
+		Compile: func(c builtin.Compiler, node *ast.BuiltinNode) {
+			count := c.Constant("count")
+			c.Compile(node.Arguments[0])
+			c.Emit(vm.OpBegin)
+			c.Emit(vm.OpPush, 0, 0)
+			c.Emit(vm.OpStore, count...)
+			c.EmitLoop(func() {
+				c.Compile(node.Arguments[1])
+				c.EmitCond(func() {
+					c.Emit(vm.OpLoad, count...)
+					c.Emit(vm.OpInc)
+					c.Emit(vm.OpStore, count...)
+				})
+			})
+			c.Emit(vm.OpLoad, count...)
+			c.Emit(vm.OpEnd)
+		},
+	})
+
+	builtin.Register(&builtin.Builtin{
+		Name: "sumBy",
+		Check: func(c builtin.Checker, node *ast.BuiltinNode) reflect.Type {
+			collection := c.Visit(node.Arguments[0])
+			if t := c.VisitClosure(node.Arguments[1], collection); t.Kind() != reflect.Int {
+				return c.Error(node.Arguments[1], "closure should return int")
+			}
+			return reflect.TypeOf(0)
+		},
+		Func: func(args ...interface{}) (interface{}, error) {
+			items := reflect.ValueOf(args[0])
+			fn := args[1].(func(...interface{}) interface{})
+
+			sum := 0
+			for i := 0; i < items.Len(); i++ {
+				sum += fn(items.Index(i).Interface(), i).(int)
+			}
+			return sum, nil
+		},
+	})
+
+	builtin.Register(&builtin.Builtin{
+		Name: "fail",
+		Check: func(c builtin.Checker, node *ast.BuiltinNode) reflect.Type {
+			return reflect.TypeOf(0)
+		},
+		Func: func(args ...interface{}) (interface{}, error) {
+			return nil, errors.New("failed")
+		},
+	})
+}
+
+type item struct {
+	Name  string
+	Price int
+}
+
+type env struct {
+	Items []item
+}
+
+func TestRegister(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`countIf(Items, {.Price > 10})`, 2},
+		{`countIf(Items, i => i.Name != "b") + countIf([1, 2], {# > 1})`, 3},
+		{`sumBy(Items, {.Price})`, 60},
+		{`sumBy(Items, (i, n) => i.Price * n)`, 80},
+		{`map(Items, i => sumBy(Items, {.Price < i.Price ? 1 : 0}))`, []interface{}{0, 1, 2}},
+	}
+
+	e := env{Items: []item{{"a", 10}, {"b", 20}, {"c", 30}}}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(env{}))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, e, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+
+		data, err := program.MarshalBinary()
+		require.NoError(t, err, test.input)
+
+		decoded := &vm.Program{}
+		require.NoError(t, decoded.UnmarshalBinary(data), test.input)
+
+		output, err = expr.Run(decoded, e, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestRegister_error(t *testing.T) {
+	_, err := expr.Compile(`sumBy(Items, {.Name})`, expr.Env(env{}))
+	assert.EqualError(t, err, "closure should return int (1:14)\n | sumBy(Items, {.Name})\n | .............^")
+
+	program, err := expr.Compile(`fail()`, expr.Env(env{}))
+	require.NoError(t, err)
+
+	_, err = expr.Run(program, env{}, nil)
+	assert.IsType(t, &vm.RuntimeError{}, err)
+	assert.EqualError(t, err, "failed (1:1)\n | fail()\n | ^")
+
+	assert.Panics(t, func() {
+		builtin.Register(&builtin.Builtin{Name: "invalid"})
+	})
+}
+
+func TestRegister_shadowed(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`sum(Items)`, "env sum"},
+		{`lower("A")`, "env lower"},
+		{`now() + 1`, 43},
+		{`string(2)`, 4},
+		{`count(Items, 7)`, 7},
+		{`len(Items)`, 3},
+	}
+
+	env := map[string]interface{}{
+		"Items":  []int{1, 2, 3},
+		"sum":    func(items []int) string { return "env sum" },
+		"lower":  func(s string) string { return "env lower" },
+		"now":    func() int { return 42 },
+		"string": func(i int) int { return i * 2 },
+		"count":  func(items []int, n int) int { return n },
+	}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(env))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+
+		// Without types funcs are found in the env given to Eval.
+		output, err = expr.Eval(test.input, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"len", "all", "none", "any", "one", "filter", "map", "count", "sum", "avg", "min", "max", "reduce", "sort", "sortBy", "groupBy", "union", "lower", "split", "format", "abs", "clamp", "int", "float", "string", "now", "date", "duration", "formatDate"} {
+		b, ok := builtin.Lookup(name)
+		require.True(t, ok, name)
+		assert.Equal(t, name, b.Name)
+	}
+
+	_, ok := builtin.Lookup("unknown")
+	assert.False(t, ok)
+}
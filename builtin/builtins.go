@@ -0,0 +1,278 @@
+package builtin
+
+import (
+	"reflect"
+
+	"github.com/jakub-gawlas/expr/ast"
+	. "github.com/jakub-gawlas/expr/vm"
+)
+
+var (
+	boolType      = reflect.TypeOf(true)
+	integerType   = reflect.TypeOf(0)
+	floatType     = reflect.TypeOf(float64(0))
+	interfaceType = reflect.TypeOf(new(interface{})).Elem()
+)
+
+func init() {
+	Register(&Builtin{
+		Name:    "len",
+		Check:   checkLen,
+		Compile: compileLen,
+	})
+	Register(&Builtin{
+		Name:    "all",
+		Check:   checkPredicate(boolType),
+		Compile: compileAll,
+	})
+	Register(&Builtin{
+		Name:    "none",
+		Check:   checkPredicate(boolType),
+		Compile: compileNone,
+	})
+	Register(&Builtin{
+		Name:    "any",
+		Check:   checkPredicate(boolType),
+		Compile: compileAny,
+	})
+	Register(&Builtin{
+		Name:    "one",
+		Check:   checkPredicate(boolType),
+		Compile: compileOne,
+	})
+	Register(&Builtin{
+		Name:    "filter",
+		Check:   checkPredicate(nil),
+		Compile: compileFilter,
+	})
+	Register(&Builtin{
+		Name:    "map",
+		Check:   checkMap,
+		Compile: compileMap,
+	})
+}
+
+// checkArguments reports an error if number of arguments is out of [min, max] range.
+func checkArguments(c Checker, node *ast.BuiltinNode, min, max int) bool {
+	if len(node.Arguments) > max {
+		c.Error(node, "too many arguments to call %v", node.Name)
+		return false
+	}
+	if len(node.Arguments) < min {
+		c.Error(node, "not enough arguments to call %v", node.Name)
+		return false
+	}
+	return true
+}
+
+func checkLen(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 1, 1) {
+		return integerType
+	}
+	param := c.Visit(node.Arguments[0])
+	if isArray(param) || isMap(param) || isString(param) {
+		return integerType
+	}
+	return c.Error(node, "invalid argument for len (type %v)", param)
+}
+
+// checkPredicate checks builtin taking an array and a closure returning bool.
+// It returns the result type, or the array type if the result is nil.
+func checkPredicate(result reflect.Type) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, 2, 2) {
+			return interfaceType
+		}
+		collection := c.Visit(node.Arguments[0])
+		closure := c.VisitClosure(node.Arguments[1], collection)
+
+		if !isArray(collection) {
+			return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+		}
+		if !isBool(closure) {
+			return c.Error(node.Arguments[1], "closure should return bool")
+		}
+		if result == nil {
+			return collection
+		}
+		return result
+	}
+}
+
+func checkMap(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 2, 2) {
+		return interfaceType
+	}
+	collection := c.Visit(node.Arguments[0])
+	closure := c.VisitClosure(node.Arguments[1], collection)
+
+	if !isArray(collection) {
+		return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+	}
+	return reflect.ArrayOf(0, closure)
+}
+
+func compileLen(c Compiler, node *ast.BuiltinNode) {
+	c.Compile(node.Arguments[0])
+	c.Emit(OpLen)
+}
+
+func compileAll(c Compiler, node *ast.BuiltinNode) {
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	var loopBreak int
+	c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+		loopBreak = c.Emit(OpJumpIfFalse, c.Placeholder()...)
+		c.Emit(OpPop)
+	})
+	c.Emit(OpTrue)
+	c.PatchJump(loopBreak)
+	c.Emit(OpEnd)
+}
+
+func compileNone(c Compiler, node *ast.BuiltinNode) {
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	var loopBreak int
+	c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+		c.Emit(OpNot)
+		loopBreak = c.Emit(OpJumpIfFalse, c.Placeholder()...)
+		c.Emit(OpPop)
+	})
+	c.Emit(OpTrue)
+	c.PatchJump(loopBreak)
+	c.Emit(OpEnd)
+}
+
+func compileAny(c Compiler, node *ast.BuiltinNode) {
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	var loopBreak int
+	c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+		loopBreak = c.Emit(OpJumpIfTrue, c.Placeholder()...)
+		c.Emit(OpPop)
+	})
+	c.Emit(OpFalse)
+	c.PatchJump(loopBreak)
+	c.Emit(OpEnd)
+}
+
+func compileOne(c Compiler, node *ast.BuiltinNode) {
+	compileCount(c, node)
+	c.Emit(OpPush, 1, 0)
+	c.Emit(OpEqual)
+}
+
+func compileCount(c Compiler, node *ast.BuiltinNode) {
+	count := c.Constant("count")
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	c.Emit(OpPush, 0, 0)
+	c.Emit(OpStore, count...)
+	c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+		c.EmitCond(func() {
+			c.Emit(OpLoad, count...)
+			c.Emit(OpInc)
+			c.Emit(OpStore, count...)
+		})
+	})
+	c.Emit(OpLoad, count...)
+	c.Emit(OpEnd)
+}
+
+func compileFilter(c Compiler, node *ast.BuiltinNode) {
+	count := c.Constant("count")
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	c.Emit(OpPush, 0, 0)
+	c.Emit(OpStore, count...)
+	c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+		c.EmitCond(func() {
+			c.Emit(OpLoad, count...)
+			c.Emit(OpInc)
+			c.Emit(OpStore, count...)
+
+			c.EmitItem()
+		})
+	})
+	c.Emit(OpLoad, count...)
+	c.Emit(OpEnd)
+	c.Emit(OpArray)
+}
+
+func compileMap(c Compiler, node *ast.BuiltinNode) {
+	c.Compile(node.Arguments[0])
+	c.Emit(OpBegin)
+	size := c.EmitLoop(func() {
+		c.Compile(node.Arguments[1])
+	})
+	c.Emit(OpLoad, size...)
+	c.Emit(OpEnd)
+	c.Emit(OpArray)
+}
+
+func dereference(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// is reports if type is of one of the kinds, or is an interface.
+func is(t reflect.Type, kinds ...reflect.Kind) bool {
+	t = dereference(t)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	for _, k := range kinds {
+		if t.Kind() == k {
+			return true
+		}
+	}
+	return false
+}
+
+func isArray(t reflect.Type) bool {
+	return is(t, reflect.Slice, reflect.Array)
+}
+
+func isMap(t reflect.Type) bool {
+	return is(t, reflect.Map)
+}
+
+func isString(t reflect.Type) bool {
+	return is(t, reflect.String)
+}
+
+func isBool(t reflect.Type) bool {
+	return is(t, reflect.Bool)
+}
+
+func isInterface(t reflect.Type) bool {
+	t = dereference(t)
+	return t != nil && t.Kind() == reflect.Interface
+}
+
+func isNumber(t reflect.Type) bool {
+	return is(t,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64)
+}
+
+// elemType returns type of items of the array, or interface type if unknown.
+func elemType(t reflect.Type) reflect.Type {
+	t = dereference(t)
+	if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		return t.Elem()
+	}
+	return interfaceType
+}
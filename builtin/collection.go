@@ -0,0 +1,571 @@
+package builtin
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/jakub-gawlas/expr/ast"
+	"github.com/jakub-gawlas/expr/vm"
+)
+
+func init() {
+	Register(&Builtin{
+		Name:  "sort",
+		Check: checkArray,
+		Func:  sortArray,
+	})
+	Register(&Builtin{
+		Name:  "sortBy",
+		Check: checkKey(false),
+		Func:  sortBy,
+	})
+	Register(&Builtin{
+		Name:  "reverse",
+		Check: checkArray,
+		Func:  reverse,
+	})
+	Register(&Builtin{
+		Name:  "unique",
+		Check: checkArray,
+		Func:  unique,
+	})
+	Register(&Builtin{
+		Name:  "groupBy",
+		Check: checkKey(true),
+		Func:  groupBy,
+	})
+	Register(&Builtin{
+		Name:  "partition",
+		Check: checkPartition,
+		Func:  partition,
+	})
+	Register(&Builtin{
+		Name:  "flatten",
+		Check: checkFlatten,
+		Func:  flatten,
+	})
+	Register(&Builtin{
+		Name:  "first",
+		Check: checkItem,
+		Func:  first,
+	})
+	Register(&Builtin{
+		Name:  "last",
+		Check: checkItem,
+		Func:  last,
+	})
+	Register(&Builtin{
+		Name:  "take",
+		Check: checkSlice,
+		Func:  take,
+	})
+	Register(&Builtin{
+		Name:  "drop",
+		Check: checkSlice,
+		Func:  drop,
+	})
+	Register(&Builtin{
+		Name:  "union",
+		Check: checkSet,
+		Func:  union,
+	})
+	Register(&Builtin{
+		Name:  "intersect",
+		Check: checkSet,
+		Func:  intersect,
+	})
+	Register(&Builtin{
+		Name:  "difference",
+		Check: checkSet,
+		Func:  difference,
+	})
+}
+
+// checkArray checks builtin taking an array, and returns the array type.
+func checkArray(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 1, 1) {
+		return interfaceType
+	}
+	collection := c.Visit(node.Arguments[0])
+	if !isArray(collection) {
+		return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+	}
+	return collection
+}
+
+// checkItem checks builtin taking an array, and returns type of its items.
+func checkItem(c Checker, node *ast.BuiltinNode) reflect.Type {
+	collection := checkArray(c, node)
+	if collection == interfaceType {
+		return interfaceType
+	}
+	return elemType(collection)
+}
+
+// checkSlice checks builtin taking an array and a number of items.
+func checkSlice(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 2, 2) {
+		return interfaceType
+	}
+	collection := c.Visit(node.Arguments[0])
+	n := c.Visit(node.Arguments[1])
+
+	if !isArray(collection) {
+		return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+	}
+	if !isInteger(n) {
+		return c.Error(node.Arguments[1], "builtin %v takes only integer number of items (got %v)", node.Name, n)
+	}
+	return collection
+}
+
+// checkKey checks builtin taking an array and a closure returning key of
+// items. If group is true, it returns map of keys to arrays of items,
+// otherwise the array type.
+func checkKey(group bool) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, 2, 2) {
+			return interfaceType
+		}
+		collection := c.Visit(node.Arguments[0])
+		key := c.VisitClosure(node.Arguments[1], collection)
+
+		if !isArray(collection) {
+			return c.Error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection)
+		}
+		if !group {
+			return collection
+		}
+		if !key.Comparable() {
+			return c.Error(node.Arguments[1], "closure should return comparable key (got %v)", key)
+		}
+		return reflect.MapOf(key, reflect.SliceOf(elemType(collection)))
+	}
+}
+
+func checkPartition(c Checker, node *ast.BuiltinNode) reflect.Type {
+	collection := checkPredicate(nil)(c, node)
+	if collection == interfaceType {
+		return interfaceType
+	}
+	return reflect.SliceOf(collection)
+}
+
+func checkFlatten(c Checker, node *ast.BuiltinNode) reflect.Type {
+	collection := checkArray(c, node)
+	if elem := dereference(elemType(collection)); elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array {
+		return reflect.SliceOf(elem.Elem())
+	}
+	return reflect.TypeOf([]interface{}{})
+}
+
+// checkSet checks builtin taking two arrays. It returns type of the arrays
+// if they are of the same type.
+func checkSet(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 2, 2) {
+		return interfaceType
+	}
+	a := c.Visit(node.Arguments[0])
+	b := c.Visit(node.Arguments[1])
+
+	for i, t := range []reflect.Type{a, b} {
+		if !isArray(t) {
+			return c.Error(node.Arguments[i], "builtin %v takes only arrays (got %v)", node.Name, t)
+		}
+	}
+	if a == b {
+		return a
+	}
+	return reflect.TypeOf([]interface{}{})
+}
+
+func isInteger(t reflect.Type) bool {
+	return is(t,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64)
+}
+
+// Funcs of collection builtins return a new array of the same type as the
+// argument. Items are compared with semantics of == and < operators, and
+// order of items is kept, unless changed by the builtin.
+
+func sortArray(args ...interface{}) (interface{}, error) {
+	v, err := array("sort", args[0])
+	if err != nil {
+		return nil, err
+	}
+	out := makeArray(v.Type(), v.Len())
+	reflect.Copy(out, v)
+	sort.SliceStable(out.Interface(), func(i, j int) bool {
+		return vm.Less(out.Index(i).Interface(), out.Index(j).Interface())
+	})
+	return out.Interface(), nil
+}
+
+func sortBy(args ...interface{}) (interface{}, error) {
+	v, err := array("sortBy", args[0])
+	if err != nil {
+		return nil, err
+	}
+	keys := applyKey(v, args[1])
+	order := make([]int, v.Len())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return vm.Less(keys[order[i]], keys[order[j]])
+	})
+
+	out := makeArray(v.Type(), v.Len())
+	for i, j := range order {
+		out.Index(i).Set(v.Index(j))
+	}
+	return out.Interface(), nil
+}
+
+func reverse(args ...interface{}) (interface{}, error) {
+	v, err := array("reverse", args[0])
+	if err != nil {
+		return nil, err
+	}
+	size := v.Len()
+	out := makeArray(v.Type(), size)
+	for i := 0; i < size; i++ {
+		out.Index(i).Set(v.Index(size - i - 1))
+	}
+	return out.Interface(), nil
+}
+
+func unique(args ...interface{}) (interface{}, error) {
+	v, err := array("unique", args[0])
+	if err != nil {
+		return nil, err
+	}
+	out := makeArray(v.Type(), 0)
+	seen := newSet()
+	for i := 0; i < v.Len(); i++ {
+		if seen.add(v.Index(i).Interface()) {
+			out = reflect.Append(out, v.Index(i))
+		}
+	}
+	return out.Interface(), nil
+}
+
+// groupBy returns map of keys to items with the key. The map is keyed with
+// type of keys if all of them are of the same type, and with interface{}
+// otherwise, so groups of empty array are map[interface{}].
+func groupBy(args ...interface{}) (interface{}, error) {
+	v, err := array("groupBy", args[0])
+	if err != nil {
+		return nil, err
+	}
+	keys := applyKey(v, args[1])
+
+	keyType := interfaceType
+	for i, key := range keys {
+		t := reflect.TypeOf(key)
+		if t == nil || !t.Comparable() || !vm.Equal(key, key) {
+			return nil, fmt.Errorf("invalid key of groupBy: %v (%T)", key, key)
+		}
+		if i == 0 {
+			keyType = t
+		} else if t != keyType {
+			keyType = interfaceType
+		}
+	}
+
+	groups := reflect.MakeMap(reflect.MapOf(keyType, reflect.SliceOf(v.Type().Elem())))
+	for i, key := range keys {
+		k := reflect.New(keyType).Elem()
+		k.Set(reflect.ValueOf(key))
+		group := groups.MapIndex(k)
+		if !group.IsValid() {
+			group = reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), 0, 1)
+		}
+		groups.SetMapIndex(k, reflect.Append(group, v.Index(i)))
+	}
+	return groups.Interface(), nil
+}
+
+// partition returns array of items satisfying the predicate, and array of the others.
+func partition(args ...interface{}) (interface{}, error) {
+	v, err := array("partition", args[0])
+	if err != nil {
+		return nil, err
+	}
+	predicate := args[1].(func(...interface{}) interface{})
+
+	pass := makeArray(v.Type(), 0)
+	fail := makeArray(v.Type(), 0)
+	for i := 0; i < v.Len(); i++ {
+		if ok, _ := predicate(v.Index(i).Interface(), i).(bool); ok {
+			pass = reflect.Append(pass, v.Index(i))
+		} else {
+			fail = reflect.Append(fail, v.Index(i))
+		}
+	}
+	out := reflect.MakeSlice(reflect.SliceOf(pass.Type()), 0, 2)
+	return reflect.Append(out, pass, fail).Interface(), nil
+}
+
+// flatten returns items of nested arrays. Items, which are not arrays, are kept as is.
+func flatten(args ...interface{}) (interface{}, error) {
+	v, err := array("flatten", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	elem := v.Type().Elem()
+	if elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array {
+		out := reflect.MakeSlice(reflect.SliceOf(elem.Elem()), 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			for j := 0; j < item.Len(); j++ {
+				out = reflect.Append(out, item.Index(j))
+			}
+		}
+		return out.Interface(), nil
+	}
+
+	out := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := reflect.ValueOf(v.Index(i).Interface())
+		if item.Kind() != reflect.Slice && item.Kind() != reflect.Array {
+			out = append(out, v.Index(i).Interface())
+			continue
+		}
+		for j := 0; j < item.Len(); j++ {
+			out = append(out, item.Index(j).Interface())
+		}
+	}
+	return out, nil
+}
+
+// first returns the first item, empty array is an error.
+func first(args ...interface{}) (interface{}, error) {
+	v, err := notEmpty("first", args[0])
+	if err != nil {
+		return nil, err
+	}
+	return v.Index(0).Interface(), nil
+}
+
+// last returns the last item, empty array is an error.
+func last(args ...interface{}) (interface{}, error) {
+	v, err := notEmpty("last", args[0])
+	if err != nil {
+		return nil, err
+	}
+	return v.Index(v.Len() - 1).Interface(), nil
+}
+
+// notEmpty returns value of the array, which must have items.
+func notEmpty(name string, value interface{}) (reflect.Value, error) {
+	v, err := array(name, value)
+	if err == nil && v.Len() == 0 {
+		err = &vm.RuntimeError{Kind: vm.EmptyArray, Message: name + " of empty array"}
+	}
+	return v, err
+}
+
+// take returns the first n items, or all of them if there are less than n.
+func take(args ...interface{}) (interface{}, error) {
+	v, n, err := arrayAndCount("take", args)
+	if err != nil {
+		return nil, err
+	}
+	out := makeArray(v.Type(), n)
+	reflect.Copy(out, v)
+	return out.Interface(), nil
+}
+
+// drop returns items without the first n, or no items if there are less than n.
+// Negative n is treated as 0 by both take and drop.
+func drop(args ...interface{}) (interface{}, error) {
+	v, n, err := arrayAndCount("drop", args)
+	if err != nil {
+		return nil, err
+	}
+	out := makeArray(v.Type(), v.Len()-n)
+	reflect.Copy(out, v.Slice(n, v.Len()))
+	return out.Interface(), nil
+}
+
+// union returns unique items of both arrays.
+func union(args ...interface{}) (interface{}, error) {
+	return combine("union", args, func(inA, inB bool) bool {
+		return true
+	})
+}
+
+// intersect returns unique items of the first array, which are in the second one.
+func intersect(args ...interface{}) (interface{}, error) {
+	return combine("intersect", args, func(inA, inB bool) bool {
+		return inA && inB
+	})
+}
+
+// difference returns unique items of the first array, which are not in the second one.
+func difference(args ...interface{}) (interface{}, error) {
+	return combine("difference", args, func(inA, inB bool) bool {
+		return inA && !inB
+	})
+}
+
+// combine returns unique items of both arrays, for which keep returns true.
+// The result is of type of the arrays, or []interface{} if their types differ.
+func combine(name string, args []interface{}, keep func(inA, inB bool) bool) (interface{}, error) {
+	a, err := array(name, args[0])
+	if err != nil {
+		return nil, err
+	}
+	b, err := array(name, args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf([]interface{}{})
+	if a.Type().Elem() == b.Type().Elem() {
+		t = a.Type()
+	}
+
+	inA, inB := newSet(), newSet()
+	for i := 0; i < a.Len(); i++ {
+		inA.add(a.Index(i).Interface())
+	}
+	for i := 0; i < b.Len(); i++ {
+		inB.add(b.Index(i).Interface())
+	}
+
+	out := makeArray(t, 0)
+	seen := newSet()
+	for _, v := range []reflect.Value{a, b} {
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i).Interface()
+			if keep(inA.has(item), inB.has(item)) && seen.add(item) {
+				value := reflect.New(t.Elem()).Elem()
+				value.Set(v.Index(i))
+				out = reflect.Append(out, value)
+			}
+		}
+	}
+	return out.Interface(), nil
+}
+
+func array(name string, arg interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(arg)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v, fmt.Errorf("builtin %v takes only array (got %T)", name, arg)
+	}
+	return v, nil
+}
+
+// arrayAndCount returns the array and number of items limited to its length.
+func arrayAndCount(name string, args []interface{}) (reflect.Value, int, error) {
+	v, err := array(name, args[0])
+	if err != nil {
+		return v, 0, err
+	}
+	n := reflect.ValueOf(args[1])
+	count := v.Len()
+	switch n.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n.Int() < 0 {
+			count = 0
+		} else if n.Int() < int64(count) {
+			count = int(n.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n.Uint() < uint64(count) {
+			count = int(n.Uint())
+		}
+	default:
+		return v, 0, fmt.Errorf("builtin %v takes only integer number of items (got %T)", name, args[1])
+	}
+	return v, count, nil
+}
+
+// makeArray makes slice with items of the array type.
+func makeArray(t reflect.Type, size int) reflect.Value {
+	if t.Kind() == reflect.Array {
+		t = reflect.SliceOf(t.Elem())
+	}
+	return reflect.MakeSlice(t, size, size)
+}
+
+// applyKey calls the closure for items of the array, and returns the results.
+func applyKey(v reflect.Value, closure interface{}) []interface{} {
+	fn := closure.(func(...interface{}) interface{})
+	keys := make([]interface{}, v.Len())
+	for i := range keys {
+		keys[i] = fn(v.Index(i).Interface(), i)
+	}
+	return keys
+}
+
+// set of values equal with semantics of the == operator. Numbers, strings
+// and bools are hashed, other values are compared one by one.
+type set struct {
+	hashed map[interface{}]struct{}
+	others []interface{}
+}
+
+func newSet() *set {
+	return &set{hashed: make(map[interface{}]struct{})}
+}
+
+// add adds the value to the set, and returns false if it is already there.
+func (s *set) add(v interface{}) bool {
+	if s.has(v) {
+		return false
+	}
+	if key, ok := hashKey(v); ok {
+		s.hashed[key] = struct{}{}
+	} else {
+		s.others = append(s.others, v)
+	}
+	return true
+}
+
+func (s *set) has(v interface{}) bool {
+	if key, ok := hashKey(v); ok {
+		_, found := s.hashed[key]
+		return found
+	}
+	for _, other := range s.others {
+		if vm.Equal(v, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// hashKey returns key of the value in the set. Numbers are converted to
+// float64, unless they are integers, so 1 and 1.0 have the same key. NaN
+// is not equal to anything, so it has no key and is never in the set.
+func hashKey(v interface{}) (interface{}, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() <= math.MaxInt64 {
+			return int64(rv.Uint()), true
+		}
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) {
+			return nil, false
+		}
+		if f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+			return int64(f), true
+		}
+		return f, true
+	case reflect.String, reflect.Bool:
+		return v, true
+	}
+	return nil, false
+}
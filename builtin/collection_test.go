@@ -0,0 +1,128 @@
+package builtin_test
+
+import (
+	"testing"
+
+	"github.com/jakub-gawlas/expr"
+	"github.com/jakub-gawlas/expr/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type product struct {
+	Name     string
+	Category string
+	Price    float64
+	Tags     []string
+}
+
+type catalog struct {
+	Ints     []int
+	Floats   []float64
+	Products []product
+}
+
+func TestCollection(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`sort(Ints)`, []int{1, 2, 2, 3, 5}},
+		{`sort(["b", "c", "a"])`, []interface{}{"a", "b", "c"}},
+		{`sort([2, 1.5, 1])`, []interface{}{1, 1.5, 2}},
+		{`map(sortBy(Products, {.Price}), {.Name})`, []interface{}{"c", "a", "b", "d"}},
+		{`map(sortBy(Products, {.Category}), {.Name})`, []interface{}{"a", "c", "b", "d"}},
+		{`map(sortBy(Products, {-.Price}), {.Name})`, []interface{}{"b", "d", "a", "c"}},
+		{`reverse(Ints)`, []int{2, 5, 2, 1, 3}},
+		{`reverse([])`, []interface{}{}},
+		{`unique(Ints)`, []int{3, 1, 2, 5}},
+		{`unique([1, 1.0, "1", 2, nil, nil])`, []interface{}{1, "1", 2, nil}},
+		{`groupBy(Ints, {# % 2 == 0})`, map[bool][]int{true: {2, 2}, false: {3, 1, 5}}},
+		{`map(groupBy(Products, {.Category})["fruit"], {.Name})`, []interface{}{"a", "c"}},
+		{`len(groupBy(Products, {.Category}))`, 2},
+		{`partition(Ints, {# > 2})`, [][]int{{3, 5}, {1, 2, 2}}},
+		{`flatten(map(Products, {.Tags}))`, []interface{}{"new", "sale", "sale"}},
+		{`flatten([[1, 2], 3, [[4]]])`, []interface{}{1, 2, 3, []interface{}{4}}},
+		{`first(Ints) + last(Ints)`, 5},
+		{`take(Ints, 2)`, []int{3, 1}},
+		{`take(Ints, 10)`, []int{3, 1, 2, 5, 2}},
+		{`drop(Ints, 3)`, []int{5, 2}},
+		{`drop(Ints, 10)`, []int{}},
+		{`take(Ints, -1)`, []int{}},
+		{`union(Ints, [4, 3])`, []interface{}{3, 1, 2, 5, 4}},
+		{`union(Ints, Ints)`, []int{3, 1, 2, 5}},
+		{`intersect(Ints, [5, 1, 7])`, []interface{}{1, 5}},
+		{`difference(Ints, [5, 1.0])`, []interface{}{3, 2}},
+		{`difference(Floats, Floats)`, []float64{}},
+	}
+
+	env := catalog{
+		Ints:   []int{3, 1, 2, 5, 2},
+		Floats: []float64{1.5, 2.5},
+		Products: []product{
+			{Name: "a", Category: "fruit", Price: 2},
+			{Name: "b", Category: "veg", Price: 3, Tags: []string{"new", "sale"}},
+			{Name: "c", Category: "fruit", Price: 1},
+			{Name: "d", Category: "veg", Price: 3, Tags: []string{"sale"}},
+		},
+	}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(catalog{}))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestCollection_error(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{`sort(Ints, 1)`, "too many arguments to call sort (1:1)\n | sort(Ints, 1)\n | ^"},
+		{`reverse(1)`, "builtin reverse takes only array (got int) (1:9)\n | reverse(1)\n | ........^"},
+		{`take(Ints, "1")`, "builtin take takes only integer number of items (got string) (1:12)\n | take(Ints, \"1\")\n | ...........^"},
+		{`groupBy(Products, {.Tags})`, "closure should return comparable key (got []string) (1:19)\n | groupBy(Products, {.Tags})\n | ..................^"},
+		{`union(Ints, 1)`, "builtin union takes only arrays (got int) (1:13)\n | union(Ints, 1)\n | ............^"},
+	}
+
+	for _, test := range tests {
+		_, err := expr.Compile(test.input, expr.Env(catalog{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+
+	_, err := expr.Eval(`sort([1, "a"])`, nil, nil)
+	assert.Error(t, err)
+
+	for _, input := range []string{`first(Ints) + 1`, `last(Ints) + 1`} {
+		program, err := expr.Compile(input, expr.Env(catalog{}))
+		require.NoError(t, err, input)
+
+		_, err = expr.Run(program, catalog{}, nil)
+		require.IsType(t, &vm.RuntimeError{}, err, input)
+		assert.Equal(t, vm.EmptyArray, err.(*vm.RuntimeError).Kind, input)
+	}
+	_, err = expr.Eval(`first([])`, nil, nil)
+	assert.EqualError(t, err, "first of empty array (1:1)\n | first([])\n | ^")
+}
+
+func TestCollection_types(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`groupBy(Products, {.Category})`, map[interface{}][]product{}},
+		{`partition(Products, {.Price > 1})`, [][]product{{}, {}}},
+		{`flatten(map(Products, {.Tags}))`, []interface{}{}},
+		{`take(Floats, 1)`, []float64{}},
+	}
+
+	for _, test := range tests {
+		output, err := expr.Eval(test.input, catalog{}, nil)
+		require.NoError(t, err, test.input)
+		assert.IsType(t, test.output, output, test.input)
+	}
+}
@@ -0,0 +1,260 @@
+package builtin
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/jakub-gawlas/expr/ast"
+	"github.com/jakub-gawlas/expr/vm"
+)
+
+func init() {
+	Register(&Builtin{
+		Name:  "abs",
+		Check: checkNumber(nil),
+		Func:  abs,
+	})
+	Register(&Builtin{
+		Name:  "round",
+		Check: checkRounding,
+		Func:  rounding(math.Round),
+	})
+	Register(&Builtin{
+		Name:  "floor",
+		Check: checkRounding,
+		Func:  rounding(math.Floor),
+	})
+	Register(&Builtin{
+		Name:  "ceil",
+		Check: checkRounding,
+		Func:  rounding(math.Ceil),
+	})
+	Register(&Builtin{
+		Name:  "sqrt",
+		Check: checkNumber(floatType),
+		Func:  mathFunc(math.Sqrt),
+	})
+	Register(&Builtin{
+		Name:  "log",
+		Check: checkNumber(floatType),
+		Func:  mathFunc(math.Log),
+	})
+	Register(&Builtin{
+		Name:  "clamp",
+		Check: checkClamp,
+		Func:  clamp,
+	})
+	Register(&Builtin{
+		Name:  "int",
+		Check: checkConversion(integerType),
+		Func:  convertInt,
+	})
+	Register(&Builtin{
+		Name:  "float",
+		Check: checkConversion(floatType),
+		Func:  convertFloat,
+	})
+	Register(&Builtin{
+		Name:  "string",
+		Check: checkConversion(stringType),
+		Func:  convertString,
+	})
+}
+
+// checkNumber checks builtin taking a number. It returns the result type,
+// or type of the number if the result is nil.
+func checkNumber(result reflect.Type) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, 1, 1) {
+			return interfaceType
+		}
+		t := c.Visit(node.Arguments[0])
+		if !isNumber(t) {
+			return c.Error(node.Arguments[0], "builtin %v takes only number (got %v)", node.Name, t)
+		}
+		if result == nil {
+			return t
+		}
+		return result
+	}
+}
+
+// checkRounding returns float64 for floats, and type of the number for
+// integers, which are returned as is.
+func checkRounding(c Checker, node *ast.BuiltinNode) reflect.Type {
+	t := checkNumber(nil)(c, node)
+	if k := dereference(t).Kind(); k == reflect.Float32 || k == reflect.Float64 {
+		return floatType
+	}
+	return t
+}
+
+// checkScalars checks builtin taking at least two values, like min(a, b, c).
+// Integer literals are typed as other numbers, so the result is of their
+// type, or interface type if the values are of different types.
+func checkScalars(c Checker, node *ast.BuiltinNode, valid func(reflect.Type) bool, values string) reflect.Type {
+	types := make([]reflect.Type, len(node.Arguments))
+	for i, arg := range node.Arguments {
+		types[i] = c.Visit(arg)
+	}
+	c.Coerce(node.Arguments, types)
+
+	for i, t := range types {
+		if !valid(t) {
+			return c.Error(node.Arguments[i], "builtin %v takes only %v (got %v)", node.Name, values, t)
+		}
+		if !isInterface(t) && !isInterface(types[0]) && isNumber(t) != isNumber(types[0]) {
+			return c.Error(node.Arguments[i], "builtin %v takes only %v (mismatched types %v and %v)", node.Name, values, types[0], t)
+		}
+	}
+	for _, t := range types[1:] {
+		if dereference(t) != dereference(types[0]) {
+			return interfaceType
+		}
+	}
+	return types[0]
+}
+
+func checkClamp(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 3, 3) {
+		return interfaceType
+	}
+	return checkScalars(c, node, isNumber, "numbers")
+}
+
+// checkConversion checks builtin converting a value to the result type.
+// Only numbers and strings can be converted to numbers.
+func checkConversion(result reflect.Type) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, 1, 1) {
+			return result
+		}
+		t := c.Visit(node.Arguments[0])
+		if result != stringType && !isNumber(t) && !isString(t) {
+			c.Error(node.Arguments[0], "cannot convert %v to %v", t, result)
+		}
+		return result
+	}
+}
+
+// Limits of int, math.MaxInt and math.MinInt are not available before Go 1.17.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+func abs(args ...interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case int:
+		if x == minInt {
+			return nil, fmt.Errorf("builtin abs overflows int (got %v)", x)
+		}
+		if x < 0 {
+			return -x, nil
+		}
+		return x, nil
+	case float64:
+		return math.Abs(x), nil
+	}
+
+	v := reflect.ValueOf(args[0])
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			out := reflect.New(v.Type()).Elem()
+			out.SetInt(-v.Int())
+			if out.Int() < 0 {
+				return nil, fmt.Errorf("builtin abs overflows %v (got %v)", v.Type(), args[0])
+			}
+			return out.Interface(), nil
+		}
+		return args[0], nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return args[0], nil
+	case reflect.Float32, reflect.Float64:
+		out := reflect.New(v.Type()).Elem()
+		out.SetFloat(math.Abs(v.Float()))
+		return out.Interface(), nil
+	}
+	return nil, fmt.Errorf("builtin abs takes only number (got %T)", args[0])
+}
+
+// rounding returns func applying fn to floats. Integers are returned as is.
+func rounding(fn func(float64) float64) vm.Func {
+	return func(args ...interface{}) (interface{}, error) {
+		if x, ok := args[0].(float64); ok {
+			return fn(x), nil
+		}
+		if v := reflect.ValueOf(args[0]); v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return fn(v.Float()), nil
+		}
+		return args[0], nil
+	}
+}
+
+// mathFunc returns func applying fn to number converted to float64.
+func mathFunc(fn func(float64) float64) vm.Func {
+	return func(args ...interface{}) (interface{}, error) {
+		return fn(vm.ToFloat64(args[0])), nil
+	}
+}
+
+// clamp returns the value limited to the range from min to max.
+func clamp(args ...interface{}) (interface{}, error) {
+	x, min, max := args[0], args[1], args[2]
+	if vm.Less(max, min) {
+		return nil, fmt.Errorf("builtin clamp takes min less or equal to max (got %v and %v)", min, max)
+	}
+	if vm.Less(x, min) {
+		return min, nil
+	}
+	if vm.Less(max, x) {
+		return max, nil
+	}
+	return x, nil
+}
+
+// convertInt converts number to int, truncating floats, or parses string
+// as a decimal integer.
+func convertInt(args ...interface{}) (interface{}, error) {
+	if s, ok := toString(args[0]); ok {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to int", s)
+		}
+		return i, nil
+	}
+	if v := reflect.ValueOf(args[0]); v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+		if !vm.IsIntRange(v.Float()) {
+			return nil, fmt.Errorf("cannot convert %v to int (overflows int)", args[0])
+		}
+	}
+	return vm.ToInt(args[0]), nil
+}
+
+// convertFloat converts number to float64, or parses string as a float.
+func convertFloat(args ...interface{}) (interface{}, error) {
+	if s, ok := toString(args[0]); ok {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to float", s)
+		}
+		return f, nil
+	}
+	return vm.ToFloat64(args[0]), nil
+}
+
+// convertString formats value as a string, like fmt.Sprint does.
+func convertString(args ...interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case string:
+		return x, nil
+	case int:
+		return strconv.Itoa(x), nil
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64), nil
+	}
+	return fmt.Sprint(args[0]), nil
+}
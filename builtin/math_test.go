@@ -0,0 +1,140 @@
+package builtin_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jakub-gawlas/expr"
+	"github.com/jakub-gawlas/expr/checker"
+	"github.com/jakub-gawlas/expr/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type measures struct {
+	Int     int
+	Int64   int64
+	Uint8   uint8
+	Float   float64
+	Float32 float32
+	Ints    []int
+	Text    string
+}
+
+func TestMath(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`abs(-5)`, 5},
+		{`abs(Int64)`, int64(7)},
+		{`abs(-Float)`, 2.5},
+		{`abs(Float32)`, float32(1.25)},
+		{`round(Float) + floor(Float) + ceil(-Float)`, 3.0 + 2.0 - 2.0},
+		{`round(2.4) == 2 and round(-2.5) == -3`, true},
+		{`round(Int64)`, int64(-7)},
+		{`floor(Float32)`, -2.0},
+		{`sqrt(16)`, 4.0},
+		{`sqrt(Uint8)`, 2.0},
+		{`log(1)`, 0.0},
+		{`min(3, 1, 2)`, 1},
+		{`max(Int64, 10)`, int64(10)},
+		{`max(Float, 1)`, 2.5},
+		{`min("b", "a")`, "a"},
+		{`min(Ints) + max(Int, 1) + max(Ints, {-#})`, 1 + 1 - 1},
+		{`map(Ints, {max(#, 2)})`, []interface{}{2, 2, 3}},
+		{`clamp(Int64, 0, 5)`, int64(0)},
+		{`clamp(Float, 0, 1)`, 1.0},
+		{`clamp(3, 1, 5)`, 3},
+		{`int(Float) + int("42") + int(Uint8)`, 2 + 42 + 4},
+		{`float(Int64) + float("0.5")`, -6.5},
+		{`string(Int) + string(Float) + string(true) + string(Text)`, "12.5trueabc"},
+		{`Int64 > -10 and Float < 10 and Float > int(Float)`, true},
+	}
+
+	env := measures{
+		Int:     1,
+		Int64:   -7,
+		Uint8:   4,
+		Float:   2.5,
+		Float32: -1.25,
+		Ints:    []int{1, 2, 3},
+		Text:    "abc",
+	}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(measures{}))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+
+	output, err := expr.Eval(`sqrt(-1)`, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(output.(float64)))
+}
+
+func TestMath_types(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output reflect.Type
+	}{
+		{`abs(Int64)`, reflect.TypeOf(int64(0))},
+		{`round(Float32)`, reflect.TypeOf(0.0)},
+		{`round(Int)`, reflect.TypeOf(0)},
+		{`min(Int64, 1, 2)`, reflect.TypeOf(int64(0))},
+		{`max(1, Float)`, reflect.TypeOf(0.0)},
+		{`max(Int, Float)`, reflect.TypeOf(new(interface{})).Elem()},
+		{`clamp(Uint8, 1, 10)`, reflect.TypeOf(uint8(0))},
+		{`int(Text)`, reflect.TypeOf(0)},
+		{`string(Ints)`, reflect.TypeOf("")},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		out, err := checker.Check(tree, checker.Env(measures{}))
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, out, test.input)
+	}
+}
+
+func TestMath_error(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{`abs(Text)`, "builtin abs takes only number (got string) (1:5)\n | abs(Text)\n | ....^"},
+		{`min(1, "a")`, "builtin min takes only numbers or strings (mismatched types int and string) (1:8)\n | min(1, \"a\")\n | .......^"},
+		{`max(Int, nil)`, "builtin max takes only numbers or strings (got <nil>) (1:10)\n | max(Int, nil)\n | .........^"},
+		{`clamp(1, 2)`, "not enough arguments to call clamp (1:1)\n | clamp(1, 2)\n | ^"},
+		{`int(Ints)`, "cannot convert []int to int (1:5)\n | int(Ints)\n | ....^"},
+	}
+
+	for _, test := range tests {
+		_, err := expr.Compile(test.input, expr.Env(measures{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+
+	var runtimeTests = []struct {
+		input string
+		err   string
+	}{
+		{`int("1.5")`, "cannot convert \"1.5\" to int (1:1)\n | int(\"1.5\")\n | ^"},
+		{`float("x")`, "cannot convert \"x\" to float (1:1)\n | float(\"x\")\n | ^"},
+		{`int(100000000000000000000.0)`, "cannot convert 1e+20 to int (overflows int) (1:1)\n | int(100000000000000000000.0)\n | ^"},
+		{`int(-100000000000000000000.0)`, "cannot convert -1e+20 to int (overflows int) (1:1)\n | int(-100000000000000000000.0)\n | ^"},
+		{`int(0.0 / 0.0)`, "cannot convert NaN to int (overflows int) (1:1)\n | int(0.0 / 0.0)\n | ^"},
+		{`abs(-9223372036854775807 - 1)`, "builtin abs overflows int (got -9223372036854775808) (1:1)\n | abs(-9223372036854775807 - 1)\n | ^"},
+		{`clamp(1, 5, 2)`, "builtin clamp takes min less or equal to max (got 5 and 2) (1:1)\n | clamp(1, 5, 2)\n | ^"},
+	}
+
+	for _, test := range runtimeTests {
+		_, err := expr.Eval(test.input, nil, nil)
+		assert.EqualError(t, err, test.err, test.input)
+	}
+}
@@ -0,0 +1,73 @@
+package builtin
+
+import "github.com/jakub-gawlas/expr/ast"
+
+// Resolve replaces calls of functions named like registered builtins with
+// builtin calls, unless defined reports that the function is defined by the
+// env. Functions of the env shadow builtins with the same name.
+func Resolve(node ast.Node, defined func(node *ast.FunctionNode) bool) ast.Node {
+	switch n := node.(type) {
+	case *ast.UnaryNode:
+		n.Node = Resolve(n.Node, defined)
+
+	case *ast.BinaryNode:
+		n.Left = Resolve(n.Left, defined)
+		n.Right = Resolve(n.Right, defined)
+
+	case *ast.MatchesNode:
+		n.Left = Resolve(n.Left, defined)
+		n.Right = Resolve(n.Right, defined)
+
+	case *ast.PropertyNode:
+		n.Node = Resolve(n.Node, defined)
+
+	case *ast.IndexNode:
+		n.Node = Resolve(n.Node, defined)
+		n.Index = Resolve(n.Index, defined)
+
+	case *ast.MethodNode:
+		n.Node = Resolve(n.Node, defined)
+		resolveAll(n.Arguments, defined)
+
+	case *ast.FunctionNode:
+		resolveAll(n.Arguments, defined)
+		if _, ok := Lookup(n.Name); ok && !defined(n) {
+			b := &ast.BuiltinNode{
+				Name:      n.Name,
+				Arguments: n.Arguments,
+			}
+			b.SetLocation(n.GetLocation())
+			return b
+		}
+
+	case *ast.BuiltinNode:
+		resolveAll(n.Arguments, defined)
+
+	case *ast.ClosureNode:
+		n.Node = Resolve(n.Node, defined)
+
+	case *ast.ConditionalNode:
+		n.Cond = Resolve(n.Cond, defined)
+		n.Exp1 = Resolve(n.Exp1, defined)
+		n.Exp2 = Resolve(n.Exp2, defined)
+
+	case *ast.ArrayNode:
+		resolveAll(n.Nodes, defined)
+
+	case *ast.MapNode:
+		for _, pair := range n.Pairs {
+			pair.Value = Resolve(pair.Value, defined)
+		}
+
+	case *ast.LetNode:
+		n.Value = Resolve(n.Value, defined)
+		n.Node = Resolve(n.Node, defined)
+	}
+	return node
+}
+
+func resolveAll(nodes []ast.Node, defined func(node *ast.FunctionNode) bool) {
+	for i := range nodes {
+		nodes[i] = Resolve(nodes[i], defined)
+	}
+}
@@ -0,0 +1,378 @@
+package builtin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jakub-gawlas/expr/ast"
+)
+
+var (
+	stringType      = reflect.TypeOf("")
+	stringArrayType = reflect.TypeOf([]string{})
+)
+
+func init() {
+	Register(&Builtin{
+		Name:  "lower",
+		Check: checkSignature(stringType, 1, stringType),
+		Func:  lower,
+	})
+	Register(&Builtin{
+		Name:  "upper",
+		Check: checkSignature(stringType, 1, stringType),
+		Func:  upper,
+	})
+	Register(&Builtin{
+		Name:  "trim",
+		Check: checkSignature(stringType, 1, stringType, stringType),
+		Func:  trim,
+	})
+	Register(&Builtin{
+		Name:  "split",
+		Check: checkSignature(stringArrayType, 2, stringType, stringType, integerType),
+		Func:  split,
+	})
+	Register(&Builtin{
+		Name:  "join",
+		Check: checkSignature(stringType, 1, stringArrayType, stringType),
+		Func:  join,
+	})
+	Register(&Builtin{
+		Name:  "replace",
+		Check: checkSignature(stringType, 3, stringType, stringType, stringType, integerType),
+		Func:  replace,
+	})
+	Register(&Builtin{
+		Name:       "repeat",
+		Check:      checkSignature(stringType, 2, stringType, integerType),
+		Func:       repeat,
+		StringSize: repeatSize,
+	})
+	Register(&Builtin{
+		Name:  "indexOf",
+		Check: checkSignature(integerType, 2, stringType, stringType),
+		Func:  indexOf,
+	})
+	Register(&Builtin{
+		Name:  "substring",
+		Check: checkSignature(stringType, 2, stringType, integerType, integerType),
+		Func:  substring,
+	})
+	Register(&Builtin{
+		Name:       "padLeft",
+		Check:      checkSignature(stringType, 2, stringType, integerType, stringType),
+		Func:       padLeft,
+		StringSize: padLeftSize,
+	})
+	Register(&Builtin{
+		Name:  "format",
+		Check: checkFormat,
+		Func:  format,
+	})
+	Register(&Builtin{
+		Name:  "equalFold",
+		Check: checkSignature(boolType, 2, stringType, stringType),
+		Func:  equalFold,
+	})
+}
+
+// checkSignature returns check hook of builtin taking arguments of the
+// types, of which the first min are required, and returning out.
+// Integer parameters accept integers of any kind.
+func checkSignature(out reflect.Type, min int, in ...reflect.Type) func(c Checker, node *ast.BuiltinNode) reflect.Type {
+	return func(c Checker, node *ast.BuiltinNode) reflect.Type {
+		if !checkArguments(c, node, min, len(in)) {
+			return out
+		}
+		for i, arg := range node.Arguments {
+			if t := c.Visit(arg); !accepts(in[i], t) {
+				c.Error(arg, "cannot use %v as argument (type %v) to call %v", t, in[i], node.Name)
+			}
+		}
+		return out
+	}
+}
+
+func checkFormat(c Checker, node *ast.BuiltinNode) reflect.Type {
+	if !checkArguments(c, node, 1, len(node.Arguments)) {
+		return stringType
+	}
+	if t := c.Visit(node.Arguments[0]); !isString(t) {
+		c.Error(node.Arguments[0], "cannot use %v as argument (type %v) to call %v", t, stringType, node.Name)
+	}
+	for _, arg := range node.Arguments[1:] {
+		c.Visit(arg)
+	}
+	return stringType
+}
+
+// accepts reports if value of type t can be used as argument of the param type.
+func accepts(param, t reflect.Type) bool {
+	switch param.Kind() {
+	case reflect.Slice:
+		return isArray(t) && accepts(param.Elem(), elemType(t))
+	case reflect.Int:
+		return isInteger(t)
+	case reflect.Struct:
+		return isInterface(t) || dereference(t) == param
+	}
+	return is(t, param.Kind())
+}
+
+func lower(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "lower", args: args}
+	s := a.string(0)
+	return strings.ToLower(s), a.err
+}
+
+func upper(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "upper", args: args}
+	s := a.string(0)
+	return strings.ToUpper(s), a.err
+}
+
+// trim returns the string without leading and trailing white space,
+// or characters of the cutset if it is passed.
+func trim(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "trim", args: args}
+	s := a.string(0)
+	if len(args) > 1 {
+		return strings.Trim(s, a.string(1)), a.err
+	}
+	return strings.TrimSpace(s), a.err
+}
+
+// split returns substrings separated by sep, at most n of them if n is passed.
+func split(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "split", args: args}
+	s, sep := a.string(0), a.string(1)
+	n := -1
+	if len(args) > 2 {
+		n = a.int(2)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	return strings.SplitN(s, sep, n), nil
+}
+
+// join concatenates strings of the array, separated by sep if it is passed.
+func join(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "join", args: args}
+	var sep string
+	if len(args) > 1 {
+		sep = a.string(1)
+	}
+	if items, ok := args[0].([]string); ok {
+		return strings.Join(items, sep), a.err
+	}
+
+	v, err := array("join", args[0])
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	for i := 0; i < v.Len(); i++ {
+		item, ok := toString(v.Index(i).Interface())
+		if !ok {
+			return nil, fmt.Errorf("builtin join takes only array of strings (got %T item)", v.Index(i).Interface())
+		}
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(item)
+	}
+	return b.String(), a.err
+}
+
+// replace returns the string with old replaced by new, only the first
+// n of them if n is passed.
+func replace(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "replace", args: args}
+	s, old, new := a.string(0), a.string(1), a.string(2)
+	n := -1
+	if len(args) > 3 {
+		n = a.int(3)
+	}
+	return strings.Replace(s, old, new, n), a.err
+}
+
+func repeat(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "repeat", args: args}
+	s, n := a.string(0), a.int(1)
+	if a.err != nil {
+		return nil, a.err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("builtin repeat takes only non-negative count (got %v)", n)
+	}
+	return strings.Repeat(s, n), nil
+}
+
+// repeatSize returns size of the string built by repeat, or the largest int
+// if it overflows.
+func repeatSize(args ...interface{}) int {
+	a := arguments{name: "repeat", args: args}
+	s, n := a.string(0), a.int(1)
+	if a.err != nil || n <= 0 {
+		return 0
+	}
+	if len(s) > maxInt/n {
+		return maxInt
+	}
+	return len(s) * n
+}
+
+// indexOf returns byte index of the first occurrence of substr, or -1 if
+// it is not present. Indexes of strings are byte indexes, as with len.
+func indexOf(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "indexOf", args: args}
+	s, substr := a.string(0), a.string(1)
+	return strings.Index(s, substr), a.err
+}
+
+// substring returns bytes of the string from start to end, or to the end
+// of the string if end is not passed.
+func substring(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "substring", args: args}
+	s, start := a.string(0), a.int(1)
+	end := len(s)
+	if len(args) > 2 {
+		end = a.int(2)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	if start < 0 || end < start || end > len(s) {
+		return nil, fmt.Errorf("substring bounds out of range [%v:%v] with length %v", start, end, len(s))
+	}
+	return s[start:end], nil
+}
+
+// padLeft returns the string padded to width characters with pad, which
+// is space if it is not passed.
+func padLeft(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "padLeft", args: args}
+	s, width := a.string(0), a.int(1)
+	pad := " "
+	if len(args) > 2 {
+		pad = a.string(2)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 || pad == "" {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(paddingSize(n, pad) + len(s))
+	for i := 0; i < n; {
+		for _, r := range pad {
+			if i == n {
+				break
+			}
+			b.WriteRune(r)
+			i++
+		}
+	}
+	b.WriteString(s)
+	return b.String(), nil
+}
+
+// padLeftSize returns size of the string built by padLeft, or the largest
+// int if it overflows.
+func padLeftSize(args ...interface{}) int {
+	a := arguments{name: "padLeft", args: args}
+	s, width := a.string(0), a.int(1)
+	pad := " "
+	if len(args) > 2 {
+		pad = a.string(2)
+	}
+	n := width - utf8.RuneCountInString(s)
+	if a.err != nil || n <= 0 || pad == "" {
+		return 0
+	}
+	size := paddingSize(n, pad)
+	if size > maxInt-len(s) {
+		return maxInt
+	}
+	return size + len(s)
+}
+
+// paddingSize returns size of n characters of the pad, or the largest int
+// if it overflows.
+func paddingSize(n int, pad string) int {
+	size := float64(n) * float64(len(pad)) / float64(utf8.RuneCountInString(pad))
+	if size >= float64(maxInt) {
+		return maxInt
+	}
+	return int(size)
+}
+
+// format formats arguments according to the format specifier of fmt.Sprintf.
+func format(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "format", args: args}
+	f := a.string(0)
+	return fmt.Sprintf(f, args[1:]...), a.err
+}
+
+// equalFold reports if the strings are equal ignoring case.
+func equalFold(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "equalFold", args: args}
+	s, t := a.string(0), a.string(1)
+	return strings.EqualFold(s, t), a.err
+}
+
+// arguments of builtin func converted to Go types. The first
+// invalid argument is reported by err.
+type arguments struct {
+	name string
+	args []interface{}
+	err  error
+}
+
+func (a *arguments) string(i int) string {
+	s, ok := toString(a.args[i])
+	if !ok && a.err == nil {
+		a.err = fmt.Errorf("builtin %v takes only string (got %T)", a.name, a.args[i])
+	}
+	return s
+}
+
+func (a *arguments) int(i int) int {
+	switch x := a.args[i].(type) {
+	case int:
+		return x
+	}
+	v := reflect.ValueOf(a.args[i])
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint())
+	}
+	if a.err == nil {
+		a.err = fmt.Errorf("builtin %v takes only integer (got %T)", a.name, a.args[i])
+	}
+	return 0
+}
+
+// toString returns value of string, including named string types and pointers to them.
+func toString(v interface{}) (string, bool) {
+	if s, ok := v.(string); ok {
+		return s, true
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.String {
+		return rv.String(), true
+	}
+	return "", false
+}
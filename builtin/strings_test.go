@@ -0,0 +1,127 @@
+package builtin_test
+
+import (
+	"testing"
+
+	"github.com/jakub-gawlas/expr"
+	"github.com/jakub-gawlas/expr/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type status string
+
+type account struct {
+	Name   string
+	Status status
+	Email  *string
+	Tags   []string
+}
+
+func TestStrings(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`lower(Name) + upper(Name)`, "john doeJOHN DOE"},
+		{`upper(Status)`, "ACTIVE"},
+		{`lower(Email)`, "john@example.com"},
+		{`trim("  a b  ")`, "a b"},
+		{`trim("\ta b\n")`, "a b"},
+		{`trim("--a-b--", "-")`, "a-b"},
+		{`split(Name, " ")`, []string{"John", "Doe"}},
+		{`split("a,b,c", ",", 2)`, []string{"a", "b,c"}},
+		{`join(Tags, ", ")`, "vip, beta"},
+		{`join(map(Tags, {upper(#)}))`, "VIPBETA"},
+		{`join(split("a-b-c", "-"), "+")`, "a+b+c"},
+		{`replace("aaa", "a", "b")`, "bbb"},
+		{`replace("aaa", "a", "b", 2)`, "bba"},
+		{`repeat("ab", 3)`, "ababab"},
+		{`indexOf(Name, "Doe")`, 5},
+		{`indexOf(Name, "x")`, -1},
+		{`substring(Name, 5)`, "Doe"},
+		{`substring(Name, 0, indexOf(Name, " "))`, "John"},
+		{`padLeft("7", 3, "0")`, "007"},
+		{`padLeft("7", 6, "ab")`, "ababa7"},
+		{`padLeft("żółw", 5)`, " żółw"},
+		{`padLeft(Name, 2)`, "John Doe"},
+		{`format("%v has %d tags (%.1f%%)", Name, len(Tags), 12.34)`, "John Doe has 2 tags (12.3%)"},
+		{`format("plain")`, "plain"},
+		{`equalFold(Name, "JOHN doe")`, true},
+		{`equalFold(Status, "inactive")`, false},
+		{`filter(Tags, {equalFold(#, "BETA")})`, []interface{}{"beta"}},
+	}
+
+	email := "John@Example.com"
+	env := account{
+		Name:   "John Doe",
+		Status: "active",
+		Email:  &email,
+		Tags:   []string{"vip", "beta"},
+	}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(account{}))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestStrings_error(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{`lower(1)`, "cannot use int as argument (type string) to call lower (1:7)\n | lower(1)\n | ......^"},
+		{`split(Name)`, "not enough arguments to call split (1:1)\n | split(Name)\n | ^"},
+		{`join(Name)`, "cannot use string as argument (type []string) to call join (1:6)\n | join(Name)\n | .....^"},
+		{`repeat(Name, "2")`, "cannot use string as argument (type int) to call repeat (1:14)\n | repeat(Name, \"2\")\n | .............^"},
+		{`format(1, 2)`, "cannot use int as argument (type string) to call format (1:8)\n | format(1, 2)\n | .......^"},
+		{`format()`, "not enough arguments to call format (1:1)\n | format()\n | ^"},
+	}
+
+	for _, test := range tests {
+		_, err := expr.Compile(test.input, expr.Env(account{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+
+	var runtimeTests = []struct {
+		input string
+		err   string
+	}{
+		{`substring("abc", 2, 5)`, "substring bounds out of range [2:5] with length 3 (1:1)\n | substring(\"abc\", 2, 5)\n | ^"},
+		{`"x" + substring("abc", 2, 1)`, "substring bounds out of range [2:1] with length 3 (1:7)\n | \"x\" + substring(\"abc\", 2, 1)\n | ......^"},
+		{`repeat("a", -1)`, "builtin repeat takes only non-negative count (got -1) (1:1)\n | repeat(\"a\", -1)\n | ^"},
+		{`join([1, 2])`, "builtin join takes only array of strings (got int item) (1:1)\n | join([1, 2])\n | ^"},
+	}
+
+	for _, test := range runtimeTests {
+		_, err := expr.Eval(test.input, nil, nil)
+		assert.EqualError(t, err, test.err, test.input)
+	}
+	var budgetTests = []struct {
+		input string
+		err   string
+	}{
+		{`repeat("ab", 10000000000)`, "budget exceeded: string size limit of 1048576 (1:1)\n | repeat(\"ab\", 10000000000)\n | ^"},
+		{`padLeft("", 10000000000)`, "budget exceeded: string size limit of 1048576 (1:1)\n | padLeft(\"\", 10000000000)\n | ^"},
+		{`padLeft("a", 600000, "é")`, "budget exceeded: string size limit of 1048576 (1:1)\n | padLeft(\"a\", 600000, \"é\")\n | ^"},
+		{`format("%2000000d", 1)`, "budget exceeded: string size limit of 1048576 (1:1)\n | format(\"%2000000d\", 1)\n | ^"},
+		{`len(join(map(1..300000, {"abcd"})))`, "budget exceeded: string size limit of 1048576 (1:5)\n | len(join(map(1..300000, {\"abcd\"})))\n | ....^"},
+	}
+
+	for _, test := range budgetTests {
+		program, err := expr.Compile(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = expr.Run(program, nil, nil, vm.MaxStringSize(1<<20))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+
+	output, err := expr.Eval(`len(repeat("ab", 1000000))`, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2000000, output)
+}
@@ -0,0 +1,132 @@
+package builtin
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Layouts tried in order by date if layout is not passed.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+func init() {
+	Register(&Builtin{
+		Name:  "now",
+		Check: checkSignature(timeType, 0),
+		Func:  now,
+	})
+	Register(&Builtin{
+		Name:  "date",
+		Check: checkSignature(timeType, 1, stringType, stringType, stringType),
+		Func:  date,
+	})
+	Register(&Builtin{
+		Name:  "duration",
+		Check: checkSignature(durationType, 1, stringType),
+		Func:  duration,
+	})
+	Register(&Builtin{
+		Name:  "formatDate",
+		Check: checkSignature(stringType, 2, timeType, stringType, stringType),
+		Func:  formatDate,
+	})
+}
+
+func now(args ...interface{}) (interface{}, error) {
+	return time.Now(), nil
+}
+
+// date parses the string as a time with the layout of time.Parse, or one
+// of dateLayouts if it is not passed. Times without offset are in the
+// time zone if it is passed, or UTC otherwise.
+func date(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "date", args: args}
+	s := a.string(0)
+	layouts := dateLayouts
+	if len(args) > 1 {
+		layouts = []string{a.string(1)}
+	}
+	zone := "UTC"
+	if len(args) > 2 {
+		zone = a.string(2)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	loc, err := location(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot parse %q as date", s)
+}
+
+func duration(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "duration", args: args}
+	s := a.string(0)
+	if a.err != nil {
+		return nil, a.err
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %q as duration", s)
+	}
+	return d, nil
+}
+
+// formatDate formats the time with the layout of time.Format, in the time
+// zone if it is passed.
+func formatDate(args ...interface{}) (interface{}, error) {
+	a := arguments{name: "formatDate", args: args}
+	t, ok := args[0].(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("builtin formatDate takes only time (got %T)", args[0])
+	}
+	layout, zone := a.string(1), ""
+	if len(args) > 2 {
+		zone = a.string(2)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	if zone != "" {
+		loc, err := location(zone)
+		if err != nil {
+			return nil, err
+		}
+		t = t.In(loc)
+	}
+	return t.Format(layout), nil
+}
+
+var locations sync.Map
+
+// location returns the time zone with the IANA name, like Europe/Warsaw.
+// Loaded time zones are cached, as loading reads the zone database.
+func location(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
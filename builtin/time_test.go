@@ -0,0 +1,86 @@
+package builtin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jakub-gawlas/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type order struct {
+	CreatedAt time.Time
+	Timeout   time.Duration
+	Retries   int
+}
+
+func TestTime(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`CreatedAt > now() - duration("72h")`, true},
+		{`CreatedAt < now() + 1h`, true},
+		{`date("2024-01-02")`, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`date("2024-01-02 15:04:05") - date("2024-01-02")`, 15*time.Hour + 4*time.Minute + 5*time.Second},
+		{`date("2024-01-02T10:00:00+02:00") == date("2024-01-02T08:00:00Z")`, true},
+		{`date("02.01.2024", "02.01.2006").Month()`, time.January},
+		{`date("2024-07-01 12:00", "2006-01-02 15:04", "Europe/Warsaw").UTC().Hour()`, 10},
+		{`date("2024-01-02").Year() == 2024 and date("2024-01-02").Day() == 2`, true},
+		{`Timeout * Retries`, 90 * time.Second},
+		{`Timeout * 2 + 3h30m`, 3*time.Hour + 31*time.Minute},
+		{`Timeout / 2 > 10s and Timeout >= 30s`, true},
+		{`1.5 * Timeout`, 45 * time.Second},
+		{`-Timeout`, -30 * time.Second},
+		{`duration("1h30m") == 90m`, true},
+		{`formatDate(date("2024-01-02T10:00:00Z"), "2006-01-02 15:04")`, "2024-01-02 10:00"},
+		{`formatDate(date("2024-07-01T10:00:00Z"), "15:04 MST", "Europe/Warsaw")`, "12:00 CEST"},
+	}
+
+	env := order{
+		CreatedAt: time.Now().Add(-time.Hour),
+		Timeout:   30 * time.Second,
+		Retries:   3,
+	}
+
+	for _, test := range tests {
+		program, err := expr.Compile(test.input, expr.Env(order{}))
+		require.NoError(t, err, test.input)
+
+		output, err := expr.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestTime_error(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{`CreatedAt + CreatedAt`, "invalid operation: + (mismatched types time.Time and time.Time) (1:11)\n | CreatedAt + CreatedAt\n | ..........^"},
+		{`CreatedAt > 1h`, "invalid operation: > (mismatched types time.Time and time.Duration) (1:11)\n | CreatedAt > 1h\n | ..........^"},
+		{`formatDate(Timeout, "15:04")`, "cannot use time.Duration as argument (type time.Time) to call formatDate (1:12)\n | formatDate(Timeout, \"15:04\")\n | ...........^"},
+		{`date(1)`, "cannot use int as argument (type string) to call date (1:6)\n | date(1)\n | .....^"},
+	}
+
+	for _, test := range tests {
+		_, err := expr.Compile(test.input, expr.Env(order{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+
+	var runtimeTests = []struct {
+		input string
+		err   string
+	}{
+		{`date("yesterday")`, "cannot parse \"yesterday\" as date (1:1)\n | date(\"yesterday\")\n | ^"},
+		{`date("2024-01-02", "2006-01-02", "Mars/Olympus")`, "unknown time zone \"Mars/Olympus\" (1:1)\n | date(\"2024-01-02\", \"2006-01-02\", \"Mars/Olympus\")\n | ^"},
+		{`duration("1 hour")`, "cannot parse \"1 hour\" as duration (1:1)\n | duration(\"1 hour\")\n | ^"},
+	}
+
+	for _, test := range runtimeTests {
+		_, err := expr.Eval(test.input, nil, nil)
+		assert.EqualError(t, err, test.err, test.input)
+	}
+}
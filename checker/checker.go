@@ -5,36 +5,59 @@ import (
 	"reflect"
 
 	"github.com/jakub-gawlas/expr/ast"
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/builtin"
+	"github.com/jakub-gawlas/expr/file"
 	"github.com/jakub-gawlas/expr/parser"
 )
 
+// Check infers type of the tree. All found type errors are returned
+// together as *file.Errors.
 func Check(tree *parser.Tree, types TypesTable) (t reflect.Type, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			if h, ok := r.(helper.Error); ok {
-				err = fmt.Errorf("%v", h.Format(tree.Source))
-			} else {
-				err = fmt.Errorf("%v", r)
-			}
+			err = fmt.Errorf("%v", r)
 		}
 	}()
 
 	v := &visitor{
 		types:       types,
 		collections: make([]reflect.Type, 0),
+		errors:      file.NewErrors(tree.Source),
 	}
 
+	// Calls of funcs not defined by the env are calls of builtins.
+	tree.Node = builtin.Resolve(tree.Node, func(node *ast.FunctionNode) bool {
+		return types.Func(node.Name)
+	})
+
 	t = v.visit(tree.Node)
+	if v.errors.HasError() {
+		return nil, v.errors
+	}
 	return
 }
 
 type visitor struct {
 	types       TypesTable
 	collections []reflect.Type
+	errors      *file.Errors
+	// receiver is set while visiting receiver of a member accessor.
+	receiver bool
+	// variables are let bindings in scope, the innermost are the last.
+	variables []variable
+	// signature is set while visiting closure passed as func argument.
+	signature reflect.Type
+}
+
+type variable struct {
+	name string
+	t    reflect.Type
 }
 
 func (v *visitor) visit(node ast.Node) reflect.Type {
+	receiver := v.receiver
+	v.receiver = false
+
 	var t reflect.Type
 	switch n := node.(type) {
 	case *ast.NilNode:
@@ -45,6 +68,8 @@ func (v *visitor) visit(node ast.Node) reflect.Type {
 		t = v.IntegerNode(n)
 	case *ast.FloatNode:
 		t = v.FloatNode(n)
+	case *ast.DurationNode:
+		t = v.DurationNode(n)
 	case *ast.BoolNode:
 		t = v.BoolNode(n)
 	case *ast.StringNode:
@@ -75,18 +100,35 @@ func (v *visitor) visit(node ast.Node) reflect.Type {
 		t = v.ArrayNode(n)
 	case *ast.MapNode:
 		t = v.MapNode(n)
+	case *ast.LetNode:
+		t = v.LetNode(n)
+	case *ast.ConstantNode:
+		t = v.ConstantNode(n)
 	default:
 		panic(fmt.Sprintf("undefined node type (%T)", node))
 	}
+
+	// Chain of accessors with ?. results in nil, once ?. meets nil.
+	if !receiver && isNilSafe(node) && !isNillable(t) {
+		t = interfaceType
+	}
+
 	node.SetType(t)
 	return t
 }
 
-func (v *visitor) error(node ast.Node, format string, args ...interface{}) helper.Error {
-	return helper.Error{
-		Location: node.GetLocation(),
-		Message:  fmt.Sprintf(format, args...),
-	}
+// visitReceiver visits receiver of a member accessor, so the type
+// of accessors chain is known only for the outermost accessor.
+func (v *visitor) visitReceiver(node ast.Node) reflect.Type {
+	v.receiver = true
+	return v.visit(node)
+}
+
+// error reports type error and returns interface type, so checking
+// of the rest of the tree can continue without cascading errors.
+func (v *visitor) error(node ast.Node, format string, args ...interface{}) reflect.Type {
+	v.errors.ReportError(node.GetLocation(), format, args...)
+	return interfaceType
 }
 
 func (v *visitor) NilNode(node *ast.NilNode) reflect.Type {
@@ -94,10 +136,15 @@ func (v *visitor) NilNode(node *ast.NilNode) reflect.Type {
 }
 
 func (v *visitor) IdentifierNode(node *ast.IdentifierNode) reflect.Type {
+	for i := len(v.variables) - 1; i >= 0; i-- {
+		if v.variables[i].name == node.Value {
+			return v.variables[i].t
+		}
+	}
 	if t, ok := v.types[node.Value]; ok {
 		return t.Type
 	}
-	panic(v.error(node, "unknown name %v", node.Value))
+	return v.error(node, "unknown name %v", node.Value)
 }
 
 func (v *visitor) IntegerNode(node *ast.IntegerNode) reflect.Type {
@@ -108,6 +155,10 @@ func (v *visitor) FloatNode(node *ast.FloatNode) reflect.Type {
 	return floatType
 }
 
+func (v *visitor) DurationNode(node *ast.DurationNode) reflect.Type {
+	return durationType
+}
+
 func (v *visitor) BoolNode(node *ast.BoolNode) reflect.Type {
 	return boolType
 }
@@ -131,32 +182,43 @@ func (v *visitor) UnaryNode(node *ast.UnaryNode) reflect.Type {
 			return t
 		}
 
+	case "~":
+		if isInteger(t) {
+			return t
+		}
+
 	default:
-		panic(v.error(node, "unknown operator (%v)", node.Operator))
+		return v.error(node, "unknown operator (%v)", node.Operator)
 	}
 
-	panic(v.error(node, `invalid operation: %v (mismatched type %v)`, node.Operator, t))
+	return v.error(node, `invalid operation: %v (mismatched type %v)`, node.Operator, t)
 }
 
 func (v *visitor) BinaryNode(node *ast.BinaryNode) reflect.Type {
 	l := v.visit(node.Left)
 	r := v.visit(node.Right)
+	// Types as written, reported in errors instead of the inferred ones.
+	left, right := l, r
 
-	if isNumber(l) && isNumber(r) && !isInterface(l) && !isInterface(r) {
+	if isNumber(l) && isNumber(r) && !isInterface(l) && !isInterface(r) && !isShift(node.Operator) {
 		// Real integer type is unknown until binary node,
 		// it maybe int, int64, float64, etc.
 		if !isCertain(node.Left) && isCertain(node.Right) {
 			l = r
-			setUncertainType(node.Left, dereference(r))
+			v.setUncertainType(node.Left, dereference(r))
 		} else if isCertain(node.Left) && !isCertain(node.Right) {
 			r = l
-			setUncertainType(node.Right, dereference(l))
+			v.setUncertainType(node.Right, dereference(l))
 		}
 	}
 
+	if t, ok := timeOperation(node.Operator, l, r); ok {
+		return t
+	}
+
 	switch node.Operator {
 	case "==", "!=":
-		if isComparable(l, r) {
+		if isComparable(l, r) || (isNumber(l) && isNumber(r)) {
 			return boolType
 		}
 
@@ -165,19 +227,22 @@ func (v *visitor) BinaryNode(node *ast.BinaryNode) reflect.Type {
 			return boolType
 		}
 
+	case "??":
+		return unifiedType(l, r)
+
 	case "in", "not in":
 		if isString(l) && isStruct(r) {
 			return boolType
 		}
 		if isArray(r) || isMap(r) {
 			if isNumber(l) && isCertain(node.Left) && !isCertain(node.Right) {
-				setUncertainType(node.Right, dereference(l))
+				v.setUncertainType(node.Right, dereference(l))
 			}
 			return boolType
 		}
 
 	case "<", ">", ">=", "<=":
-		if isNumber(l) && isNumber(r) && isComparable(l, r) {
+		if isNumber(l) && isNumber(r) {
 			return boolType
 		}
 		if isString(l) && isString(r) {
@@ -185,30 +250,43 @@ func (v *visitor) BinaryNode(node *ast.BinaryNode) reflect.Type {
 		}
 
 	case "/", "-", "*":
-		if isNumber(l) && isNumber(r) && isComparable(l, r) {
-			return l
+		if isNumber(l) && isNumber(r) {
+			return promotedType(l, r)
 		}
 
 	case "**":
-		if isNumber(l) && isNumber(r) && isComparable(l, r) {
+		if isNumber(l) && isNumber(r) {
 			if !isCertain(node.Left) {
-				setUncertainType(node.Left, integerType)
+				v.setUncertainType(node.Left, integerType)
 			}
 			if !isCertain(node.Right) {
-				setUncertainType(node.Right, integerType)
+				v.setUncertainType(node.Right, integerType)
 			}
 			return floatType
 		}
 
-	case "%":
-		if isInteger(l) && isInteger(r) && isComparable(l, r) {
-			return integerType
+	case "%", "&", "|", "^", "&^":
+		if isInteger(l) && isInteger(r) {
+			return promotedType(l, r)
 		}
 
-	case "+":
-		if isNumber(l) && isNumber(r) && isComparable(l, r) {
+	case "<<", ">>":
+		if isInteger(l) && isInteger(r) {
+			// Shifted integer keeps its type, literals are typed as int.
+			if !isCertain(node.Left) {
+				v.setUncertainType(node.Left, integerType)
+				l = integerType
+			}
+			if !isCertain(node.Right) {
+				v.setUncertainType(node.Right, integerType)
+			}
 			return l
 		}
+
+	case "+":
+		if isNumber(l) && isNumber(r) {
+			return promotedType(l, r)
+		}
 		if isString(l) && isString(r) {
 			return stringType
 		}
@@ -224,11 +302,11 @@ func (v *visitor) BinaryNode(node *ast.BinaryNode) reflect.Type {
 		}
 
 	default:
-		panic(v.error(node, "unknown operator (%v)", node.Operator))
+		return v.error(node, "unknown operator (%v)", node.Operator)
 
 	}
 
-	panic(v.error(node, `invalid operation: %v (mismatched types %v and %v)`, node.Operator, l, r))
+	return v.error(node, `invalid operation: %v (mismatched types %v and %v)`, node.Operator, left, right)
 }
 
 func (v *visitor) MatchesNode(node *ast.MatchesNode) reflect.Type {
@@ -239,31 +317,31 @@ func (v *visitor) MatchesNode(node *ast.MatchesNode) reflect.Type {
 		return stringType
 	}
 
-	panic(v.error(node, `invalid operation: matches (mismatched types %v and %v)`, l, r))
+	return v.error(node, `invalid operation: matches (mismatched types %v and %v)`, l, r)
 }
 
 func (v *visitor) PropertyNode(node *ast.PropertyNode) reflect.Type {
-	t := v.visit(node.Node)
+	t := v.visitReceiver(node.Node)
 
 	if t, ok := fieldType(t, node.Property); ok {
 		return t
 	}
 
-	panic(v.error(node, "type %v has no field %v", t, node.Property))
+	return v.error(node, "type %v has no field %v", t, node.Property)
 }
 
 func (v *visitor) IndexNode(node *ast.IndexNode) reflect.Type {
-	t := v.visit(node.Node)
+	t := v.visitReceiver(node.Node)
 	i := v.visit(node.Index)
 
 	if t, ok := indexType(t); ok {
 		if !isInteger(i) && !isString(i) {
-			panic(v.error(node, "invalid operation: can't use %v as index to %v", i, t))
+			return v.error(node, "invalid operation: can't use %v as index to %v", i, t)
 		}
 		return t
 	}
 
-	panic(v.error(node, "invalid operation: type %v does not support indexing", t))
+	return v.error(node, "invalid operation: type %v does not support indexing", t)
 }
 
 func (v *visitor) FunctionNode(node *ast.FunctionNode) reflect.Type {
@@ -273,224 +351,266 @@ func (v *visitor) FunctionNode(node *ast.FunctionNode) reflect.Type {
 				return interfaceType
 			}
 
-			if fn.NumOut() == 0 {
-				panic(v.error(node, "func %v doesn't return value", node.Name))
-			}
-			if fn.NumOut() != 1 {
-				panic(v.error(node, "func %v returns more then one value", node.Name))
-			}
-
-			numIn := fn.NumIn()
-
-			// If func is method on an env, first argument should be a receiver,
-			// and actual arguments less then numIn by one.
-			if f.method {
-				numIn--
-			}
-
-			if len(node.Arguments) > numIn {
-				panic(v.error(node, "too many arguments to call %v", node.Name))
-			}
-			if len(node.Arguments) < numIn {
-				panic(v.error(node, "not enough arguments to call %v", node.Name))
-			}
-
-			n := 0
-
-			// Skip first argument in case of the receiver.
-			if f.method {
-				n = 1
-			}
-
-			for _, arg := range node.Arguments {
-				t := v.visit(arg)
-				in := fn.In(n)
-
-				if !isCertain(arg) {
-					t = in
-					setUncertainType(arg, in)
-				}
-
-				if !t.AssignableTo(in) {
-					panic(v.error(arg, "can't use %v as argument (type %v) to call %v ", t, in, node.Name))
-				}
-				n++
-			}
-
-			return fn.Out(0)
-
+			// If func is method on an env, first argument should be a receiver.
+			return v.checkFunc(node, "func", node.Name, fn, f.method, node.Arguments)
 		}
 	}
-	panic(v.error(node, "unknown func %v", node.Name))
+	v.visitAll(node.Arguments)
+	return v.error(node, "unknown func %v", node.Name)
 }
 
 func (v *visitor) MethodNode(node *ast.MethodNode) reflect.Type {
-	t := v.visit(node.Node)
+	t := v.visitReceiver(node.Node)
 	if f, method, ok := methodType(t, node.Method); ok {
 		if fn, ok := funcType(f); ok {
 			if isInterface(fn) {
 				return interfaceType
 			}
 
-			if fn.NumOut() == 0 {
-				panic(v.error(node, "method %v doesn't return value", node.Method))
-			}
-			if fn.NumOut() != 1 {
-				panic(v.error(node, "method %v returns more then one value", node.Method))
-			}
+			return v.checkFunc(node, "method", node.Method, fn, method, node.Arguments)
+		}
+	}
+	v.visitAll(node.Arguments)
+	return v.error(node, "type %v has no method %v", t, node.Method)
+}
 
-			numIn := fn.NumIn()
+func (v *visitor) checkFunc(node ast.Node, kind, name string, fn reflect.Type, method bool, arguments []ast.Node) reflect.Type {
+	if fn.NumOut() == 0 {
+		v.visitAll(arguments)
+		return v.error(node, "%v %v doesn't return value", kind, name)
+	}
+	if fn.NumOut() != 1 {
+		v.visitAll(arguments)
+		return v.error(node, "%v %v returns more then one value", kind, name)
+	}
 
-			// If func is method, first argument should be a receiver,
-			// and actual arguments less then numIn by one.
-			if method {
-				numIn--
-			}
+	numIn := fn.NumIn()
 
-			if len(node.Arguments) > numIn {
-				panic(v.error(node, "too many arguments to call %v", node.Method))
-			}
-			if len(node.Arguments) < numIn {
-				panic(v.error(node, "not enough arguments to call %v", node.Method))
-			}
+	// If func is method, first argument should be a receiver,
+	// and actual arguments less then numIn by one.
+	if method {
+		numIn--
+	}
 
-			n := 0
+	if len(arguments) > numIn {
+		v.error(node, "too many arguments to call %v", name)
+		v.visitAll(arguments)
+		return fn.Out(0)
+	}
+	if len(arguments) < numIn {
+		v.error(node, "not enough arguments to call %v", name)
+		v.visitAll(arguments)
+		return fn.Out(0)
+	}
 
-			// Skip first argument in case of the receiver.
-			if method {
-				n = 1
-			}
+	n := 0
 
-			for _, arg := range node.Arguments {
-				t := v.visit(arg)
-				in := fn.In(n)
+	// Skip first argument in case of the receiver.
+	if method {
+		n = 1
+	}
 
-				if !isCertain(arg) {
-					t = in
-					setUncertainType(arg, in)
-				}
+	for _, arg := range arguments {
+		in := fn.In(n)
 
-				if !t.AssignableTo(in) {
-					panic(v.error(arg, "can't use %v as argument (type %v) to call %v ", t, in, node.Method))
-				}
-				n++
+		if _, ok := arg.(*ast.ClosureNode); ok && in.Kind() == reflect.Func {
+			v.signature = in
+			if t := v.visit(arg); t != in {
+				// The closure has already reported why it can't be used.
+				return fn.Out(0)
 			}
+			n++
+			continue
+		}
 
-			return fn.Out(0)
+		t := v.visit(arg)
+		if !isCertain(arg) {
+			t = in
+			v.setUncertainType(arg, in)
+		}
 
+		if !t.AssignableTo(in) {
+			v.error(arg, "can't use %v as argument (type %v) to call %v ", t, in, name)
 		}
+		n++
 	}
-	panic(v.error(node, "type %v has no method %v", t, node.Method))
+
+	return fn.Out(0)
 }
 
-func (v *visitor) BuiltinNode(node *ast.BuiltinNode) reflect.Type {
-	switch node.Name {
+// visitAll checks nodes which types are not needed, to report their errors.
+func (v *visitor) visitAll(nodes []ast.Node) {
+	for _, node := range nodes {
+		v.visit(node)
+	}
+}
 
-	case "len":
-		param := v.visit(node.Arguments[0])
-		if isArray(param) || isMap(param) || isString(param) {
-			return integerType
-		}
-		panic(v.error(node, "invalid argument for len (type %v)", param))
+func (v *visitor) BuiltinNode(node *ast.BuiltinNode) reflect.Type {
+	b, ok := builtin.Lookup(node.Name)
+	if !ok {
+		v.visitAll(node.Arguments)
+		return v.error(node, "unknown builtin %v", node.Name)
+	}
+	t := b.Check(v, node)
+	// Integer literals of arguments not typed by the check hook are int, so
+	// they are not changed by operators using result of the builtin.
+	for _, arg := range node.Arguments {
+		v.setUncertainType(arg, integerType)
+	}
+	return t
+}
 
-	case "all", "none", "any", "one":
-		collection := v.visit(node.Arguments[0])
+// Visit, VisitClosure, Error, Bind and Coerce implement builtin.Checker for check hooks.
 
-		v.collections = append(v.collections, collection)
-		closure := v.visit(node.Arguments[1])
-		v.collections = v.collections[:len(v.collections)-1]
+func (v *visitor) Visit(node ast.Node) reflect.Type {
+	return v.visit(node)
+}
 
-		if isArray(collection) {
-			if isFunc(closure) &&
-				closure.NumOut() == 1 && isBool(closure.Out(0)) &&
-				closure.NumIn() == 1 && isInterface(closure.In(0)) {
+func (v *visitor) VisitClosure(node ast.Node, collection reflect.Type) reflect.Type {
+	if _, ok := node.(*ast.ClosureNode); !ok {
+		v.visit(node)
+		return v.error(node, "closure expected")
+	}
 
-				return boolType
+	v.collections = append(v.collections, collection)
+	closure := v.visit(node)
+	v.collections = v.collections[:len(v.collections)-1]
 
-			}
-			panic(v.error(node.Arguments[1], "closure should return bool"))
-		}
-		panic(v.error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection))
+	if isFunc(closure) && closure.NumOut() == 1 {
+		return closure.Out(0)
+	}
+	return interfaceType
+}
 
-	case "filter":
-		collection := v.visit(node.Arguments[0])
+func (v *visitor) Error(node ast.Node, format string, args ...interface{}) reflect.Type {
+	return v.error(node, format, args...)
+}
 
-		v.collections = append(v.collections, collection)
-		closure := v.visit(node.Arguments[1])
-		v.collections = v.collections[:len(v.collections)-1]
+func (v *visitor) Bind(name string, t reflect.Type, body func()) {
+	v.variables = append(v.variables, variable{name: "#" + name, t: t})
+	body()
+	v.variables = v.variables[:len(v.variables)-1]
+}
 
-		if isArray(collection) {
-			if isFunc(closure) &&
-				closure.NumOut() == 1 && isBool(closure.Out(0)) &&
-				closure.NumIn() == 1 && isInterface(closure.In(0)) {
+func (v *visitor) Coerce(nodes []ast.Node, types []reflect.Type) {
+	var t reflect.Type
+	for i, node := range nodes {
+		if isNumber(types[i]) && !isInterface(types[i]) && isCertain(node) {
+			t = dereference(types[i])
+			break
+		}
+	}
+	if t == nil {
+		return
+	}
+	for i, node := range nodes {
+		if isNumber(types[i]) && !isInterface(types[i]) && !isCertain(node) {
+			v.setUncertainType(node, t)
+			types[i] = t
+		}
+	}
+}
 
-				return collection
+func (v *visitor) ClosureNode(node *ast.ClosureNode) reflect.Type {
+	if v.signature != nil {
+		signature := v.signature
+		v.signature = nil
+		return v.checkClosure(node, signature)
+	}
+	if len(v.collections) == 0 {
+		return v.error(node, "closure can be used only as an argument of builtin or func")
+	}
 
-			}
-			panic(v.error(node.Arguments[1], "closure should return bool"))
+	if len(node.Params) > 0 {
+		elem := interfaceType
+		if t, ok := indexType(v.collections[len(v.collections)-1]); ok {
+			elem = t
 		}
-		panic(v.error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection))
+		v.variables = append(v.variables, variable{name: node.Params[0], t: elem})
+		if len(node.Params) > 1 {
+			v.variables = append(v.variables, variable{name: node.Params[1], t: integerType})
+		}
+		defer func() { v.variables = v.variables[:len(v.variables)-len(node.Params)] }()
+	}
 
-	case "map":
-		collection := v.visit(node.Arguments[0])
+	t := v.visit(node.Node)
+	return reflect.FuncOf([]reflect.Type{interfaceType}, []reflect.Type{t}, false)
+}
 
-		v.collections = append(v.collections, collection)
-		closure := v.visit(node.Arguments[1])
-		v.collections = v.collections[:len(v.collections)-1]
+// checkClosure checks closure passed to a func, which calls it with
+// parameters of the signature, and returns the signature if it is valid.
+func (v *visitor) checkClosure(node *ast.ClosureNode, signature reflect.Type) reflect.Type {
+	numIn := signature.NumIn()
+	if len(node.Params) == 0 && numIn > 1 {
+		return v.error(node, "closure without named parameters can't be used as %v", signature)
+	}
+	if len(node.Params) > 0 && len(node.Params) != numIn {
+		return v.error(node, "closure with %v parameters can't be used as %v", len(node.Params), signature)
+	}
+	if signature.NumOut() > 1 {
+		return v.error(node, "closure can't be used as %v, which returns more then one value", signature)
+	}
 
-		if isArray(collection) {
-			if isFunc(closure) &&
-				closure.NumOut() == 1 &&
-				closure.NumIn() == 1 && isInterface(closure.In(0)) {
+	// Closure without named parameters accesses the only one with #.
+	pointer := interfaceType
+	if numIn > 0 {
+		pointer = reflect.SliceOf(signature.In(0))
+	}
+	v.collections = append(v.collections, pointer)
+	for i, name := range node.Params {
+		v.variables = append(v.variables, variable{name: name, t: signature.In(i)})
+	}
 
-				return reflect.ArrayOf(0, closure.Out(0))
+	t := v.visit(node.Node)
 
-			}
-			panic(v.error(node.Arguments[1], "closure should return bool"))
-		}
-		panic(v.error(node.Arguments[0], "builtin %v takes only array (got %v)", node.Name, collection))
+	v.variables = v.variables[:len(v.variables)-len(node.Params)]
+	v.collections = v.collections[:len(v.collections)-1]
 
-	default:
-		panic(v.error(node, "unknown builtin %v", node.Name))
+	if signature.NumOut() == 1 {
+		out := signature.Out(0)
+		if isNumber(t) && !isInterface(t) && isNumber(out) && !isInterface(out) && !isCertain(node.Node) {
+			v.setUncertainType(node.Node, out)
+			t = dereference(out)
+		}
+		// Integers are converted to floats by vm, other numbers must be of
+		// the result type, as conversion could truncate them.
+		if !t.AssignableTo(out) && !isInterface(t) && !(isInteger(t) && isFloat(out)) {
+			return v.error(node, "closure should return %v (got %v)", out, t)
+		}
 	}
-}
-
-func (v *visitor) ClosureNode(node *ast.ClosureNode) reflect.Type {
-	t := v.visit(node.Node)
-	return reflect.FuncOf([]reflect.Type{interfaceType}, []reflect.Type{t}, false)
+	return signature
 }
 
 func (v *visitor) PointerNode(node *ast.PointerNode) reflect.Type {
+	if node.Name != "" {
+		for i := len(v.variables) - 1; i >= 0; i-- {
+			if v.variables[i].name == "#"+node.Name {
+				return v.variables[i].t
+			}
+		}
+		return v.error(node, "unknown pointer #%v", node.Name)
+	}
+	if len(v.collections) == 0 {
+		return v.error(node, "pointer # can be used only within closure")
+	}
 	collection := v.collections[len(v.collections)-1]
 
 	if t, ok := indexType(collection); ok {
 		return t
 	}
-	panic(v.error(node, "can't use %v as array", collection))
+	return v.error(node, "can't use %v as array", collection)
 }
 
 func (v *visitor) ConditionalNode(node *ast.ConditionalNode) reflect.Type {
 	c := v.visit(node.Cond)
 	if !isBool(c) {
-		panic(v.error(node.Cond, "non-bool expression (type %v) used as condition", c))
+		v.error(node.Cond, "non-bool expression (type %v) used as condition", c)
 	}
 
 	t1 := v.visit(node.Exp1)
 	t2 := v.visit(node.Exp2)
 
-	if t1 == nil && t2 != nil {
-		return t2
-	}
-	if t1 != nil && t2 == nil {
-		return t1
-	}
-	if t1 == nil && t2 == nil {
-		return nilType
-	}
-	if t1.AssignableTo(t2) {
-		return t1
-	}
-	return interfaceType
+	return unifiedType(t1, t2)
 }
 
 func (v *visitor) ArrayNode(node *ast.ArrayNode) reflect.Type {
@@ -506,3 +626,16 @@ func (v *visitor) MapNode(node *ast.MapNode) reflect.Type {
 	}
 	return mapType
 }
+
+func (v *visitor) LetNode(node *ast.LetNode) reflect.Type {
+	t := v.visit(node.Value)
+
+	v.variables = append(v.variables, variable{name: node.Name, t: t})
+	defer func() { v.variables = v.variables[:len(v.variables)-1] }()
+
+	return v.visit(node.Node)
+}
+
+func (v *visitor) ConstantNode(node *ast.ConstantNode) reflect.Type {
+	return reflect.TypeOf(node.Value)
+}
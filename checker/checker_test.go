@@ -3,8 +3,11 @@ package checker_test
 import (
 	"fmt"
 	"github.com/jakub-gawlas/expr/checker"
+	"github.com/jakub-gawlas/expr/file"
 	"github.com/jakub-gawlas/expr/parser"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -38,7 +41,8 @@ func TestVisitor_FunctionNode(t *testing.T) {
 	assert.NoError(t, err)
 
 	if err == nil {
-		assert.Equal(t, "int64", out.Name())
+		// Result of arithmetic with interface{} operand is known only at runtime.
+		assert.Equal(t, reflect.Interface, out.Kind())
 	}
 }
 
@@ -60,7 +64,8 @@ func TestVisitor_MethodNode(t *testing.T) {
 	assert.NoError(t, err)
 
 	if err == nil {
-		assert.Equal(t, "int64", out.Name())
+		// Result of arithmetic with interface{} operand is known only at runtime.
+		assert.Equal(t, reflect.Interface, out.Kind())
 	}
 }
 
@@ -132,6 +137,7 @@ func TestCheck(t *testing.T) {
 		"'foo' startsWith 'bar'",
 		"1 < Float",
 		"1 <= Float",
+		"Int + Float > Int % 2 && Float == Int",
 		"1 == 2 and true or Bool",
 		"1 == FloatPtr",
 		"1 > Float",
@@ -158,6 +164,8 @@ func TestCheck(t *testing.T) {
 		"Foo.Fn()",
 		"Foo2p.Bar.Baz",
 		"Int % Int > 1",
+		"Int & 0x4 != 0 || Int | Int ^ Int &^ Int > 1",
+		"~Int << 2 >> Int",
 		"Int + Int + Int",
 		"Int == Any",
 		"Int in Int..Int",
@@ -184,8 +192,15 @@ func TestCheck(t *testing.T) {
 		"SubSub.SubStr",
 		"true == false",
 		"true ? Any : Any",
+		"SortBy(ArrayOfFoo, {.Bar.Baz})[0].Bar",
+		"SortBy(ArrayOfFoo, f => f.Bar.Baz + String)",
+		"Less((a, b) => a < b)",
+		"Less((a, b) => all(ArrayOfFoo, {a < b}))",
 		"{id: Foo.Bar.Baz, 'str': Bool}",
 		`"a" < "b"`,
+		"Apply(x => x * 2, 1) + Apply(x => 1, 2) + Apply({# + 1}, 3)",
+		"Scale(x => x + 1, 1) + Scale(x => x * 0.5, 2)",
+		"Int8 == -128 and Uint8 == 255 and Uint8 in [0, 1 + 2] and max(Uint8, 200 + 55) > 0",
 	}
 	for _, test := range typeTests {
 		var err error
@@ -336,6 +351,26 @@ func TestCheck_error(t *testing.T) {
 			"not IntPtr",
 			"invalid operation: not (mismatched type *int)",
 		},
+		{
+			"Int & Float",
+			"invalid operation: & (mismatched types int and float64)",
+		},
+		{
+			"1.5 & 1",
+			"invalid operation: & (mismatched types float64 and int)",
+		},
+		{
+			"Float | 1",
+			"invalid operation: | (mismatched types float64 and int)",
+		},
+		{
+			"String << 1",
+			"invalid operation: << (mismatched types string and int)",
+		},
+		{
+			"~Float",
+			"invalid operation: ~ (mismatched type float64)",
+		},
 		{
 			"len(Not)",
 			"unknown name Not",
@@ -408,6 +443,58 @@ func TestCheck_error(t *testing.T) {
 			`map(Any, {0})[0] + "str"`,
 			`invalid operation: + (mismatched types int and string)`,
 		},
+		{
+			`map(ArrayOfFoo, Foo)`,
+			`closure expected`,
+		},
+		{
+			`len(String, 1)`,
+			`too many arguments to call len`,
+		},
+		{
+			`all(ArrayOfFoo)`,
+			`not enough arguments to call all`,
+		},
+		{
+			`SortBy(ArrayOfFoo, {.Nope})`,
+			`type *checker_test.foo has no field Nope`,
+		},
+		{
+			`SortBy(ArrayOfFoo, (a, b) => a)`,
+			`closure with 2 parameters can't be used as func(*checker_test.foo) interface {}`,
+		},
+		{
+			`Less({# > 0})`,
+			`closure without named parameters can't be used as func(int, int) bool`,
+		},
+		{
+			`Less((a, b) => a + b)`,
+			`closure should return bool (got int)`,
+		},
+		{
+			`Fn(true, 1, 'str', {#})`,
+			`closure can be used only as an argument of builtin or func`,
+		},
+		{
+			`Uint8 > -1`,
+			`constant -1 overflows uint8`,
+		},
+		{
+			`Uint8 == 456`,
+			`constant 456 overflows uint8`,
+		},
+		{
+			`Uint8 in [1, 300]`,
+			`constant 300 overflows uint8`,
+		},
+		{
+			`max(Uint8, 300)`,
+			`constant 300 overflows uint8`,
+		},
+		{
+			`Int8 == 1 - 130`,
+			`constant -129 overflows int8`,
+		},
 	}
 
 	re, _ := regexp.Compile(`\s*\(\d+:\d+\)\s*`)
@@ -430,6 +517,192 @@ func TestCheck_error(t *testing.T) {
 	}
 }
 
+func TestCheck_NilSafe(t *testing.T) {
+	var tests = []struct {
+		input string
+		kind  reflect.Kind
+	}{
+		{"Foo?.Bar", reflect.Interface},
+		{"Foo?.Bar.Baz", reflect.Interface},
+		{"Foo?.Fn()", reflect.Interface},
+		{"Map['foo']?.Abc", reflect.Interface},
+		{"Foo2p?.Bar", reflect.Interface},
+		{"Map?.foo", reflect.Ptr},
+		{"Foo?.Bar.Baz == nil", reflect.Bool},
+		{"len(Foo?.Bar.Baz + 'a')", reflect.Int},
+		{"Map['foo'] ?? Foo", reflect.Ptr},
+		{"Int ?? 1", reflect.Int},
+		{"Foo?.Bar.Baz ?? 'baz'", reflect.Interface},
+		{"Map.foo?.Bar.Baz ?? Foo ?? nil", reflect.Interface},
+	}
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		out, err := checker.Check(tree, checker.Env(mockEnv2{}))
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.kind, out.Kind(), test.input)
+	}
+
+	tree, err := parser.Parse("Foo?.Bar.Not")
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+	assert.EqualError(t, err, "type checker_test.bar has no field Not (1:1)\n | Foo?.Bar.Not\n | ^")
+}
+
+func TestCheck_Let(t *testing.T) {
+	var tests = []struct {
+		input string
+		kind  reflect.Kind
+	}{
+		{"let x = Int; x + 1", reflect.Int},
+		{"let x = Foo.Bar; x.Baz", reflect.String},
+		{"let Int = 'str'; Int + String", reflect.String},
+		{"let x = 1; let x = 'str'; x", reflect.String},
+		{"(let x = 'str'; x) + String", reflect.String},
+		{"let x = 1; map(ArrayOfFoo, {x + 1})", reflect.Array},
+	}
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		out, err := checker.Check(tree, checker.Env(mockEnv2{}))
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.kind, out.Kind(), test.input)
+	}
+
+	tree, err := parser.Parse("(let x = 1; x) + x")
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+	assert.EqualError(t, err, "unknown name x (1:18)\n | (let x = 1; x) + x\n | .................^")
+}
+
+func TestCheck_ClosureParams(t *testing.T) {
+	var tests = []struct {
+		input string
+		elem  reflect.Type
+	}{
+		{"map(ArrayOfFoo, f => f.Bar.Baz)", reflect.TypeOf("")},
+		{"map(ArrayOfFoo, (f, i) => i)", reflect.TypeOf(0)},
+		{"map(ArrayOfFoo, f => map(ArrayOfAny, {f.Bar}))", reflect.TypeOf([]bar{}).Elem()},
+		{"map(ArrayOfFoo, f => all(ArrayOfFoo, g => g.Bar == f.Bar))", reflect.TypeOf(true)},
+	}
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		out, err := checker.Check(tree, checker.Env(mockEnv2{}))
+		require.NoError(t, err, test.input)
+		if out.Elem().Kind() == reflect.Array {
+			out = out.Elem()
+		}
+		assert.Equal(t, test.elem, out.Elem(), test.input)
+	}
+
+	tree, err := parser.Parse("map(ArrayOfFoo, f => f.Not)")
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+	assert.EqualError(t, err, "type *checker_test.foo has no field Not (1:22)\n | map(ArrayOfFoo, f => f.Not)\n | .....................^")
+
+	var errorTests = []struct {
+		input string
+		err   string
+	}{
+		{"Apply(x => 1.5, 1)", "closure should return int (got float64) (1:7)\n | Apply(x => 1.5, 1)\n | ......^"},
+		{"Apply(x => x * 1.5, 1)", "closure should return int (got float64) (1:7)\n | Apply(x => x * 1.5, 1)\n | ......^"},
+		{"Apply((x, y) => x, 1)", "closure with 2 parameters can't be used as func(int) int (1:7)\n | Apply((x, y) => x, 1)\n | ......^"},
+	}
+	for _, test := range errorTests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+}
+
+func TestCheck_Aggregate(t *testing.T) {
+	var tests = []struct {
+		input string
+		out   reflect.Type
+	}{
+		{"sum(ArrayOfInt)", reflect.TypeOf(0)},
+		{"sum(ArrayOfFloat)", reflect.TypeOf(0.0)},
+		{"sum(map(ArrayOfFoo, {len(.Bar.Baz)}))", reflect.TypeOf(0)},
+		{"max(map(ArrayOfInt, {# * 1.5}))", reflect.TypeOf(0.0)},
+		{"min(ArrayOfFoo, {.Bar.Baz})", reflect.TypeOf("")},
+		{"min(ArrayOfAny)", reflect.TypeOf(new(interface{})).Elem()},
+		{"count(ArrayOfFoo, {.Bar.Baz == String})", reflect.TypeOf(0)},
+		{"avg(ArrayOfInt)", reflect.TypeOf(0.0)},
+		{"reduce(ArrayOfInt, {#acc + #})", reflect.TypeOf(0)},
+		{"reduce(ArrayOfInt, {#acc + #}, 0.5)", reflect.TypeOf(0.0)},
+		{"reduce(ArrayOfFoo, {#acc + .Bar.Baz}, '')", reflect.TypeOf("")},
+	}
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		out, err := checker.Check(tree, checker.Env(mockEnv2{}))
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.out, out, test.input)
+	}
+
+	var errorTests = []struct {
+		input string
+		err   string
+	}{
+		{"sum(ArrayOfFoo)", "builtin sum takes only array of numbers (got []*checker_test.foo) (1:5)\n | sum(ArrayOfFoo)\n | ....^"},
+		{"max(ArrayOfFoo, {.Bar})", "closure should return numbers or strings (got checker_test.bar) (1:17)\n | max(ArrayOfFoo, {.Bar})\n | ................^"},
+		{"sum(Int)", "builtin sum takes only array (got int) (1:5)\n | sum(Int)\n | ....^"},
+		{"reduce(ArrayOfInt)", "not enough arguments to call reduce (1:1)\n | reduce(ArrayOfInt)\n | ^"},
+		{"map(ArrayOfInt, {#acc})", "unknown pointer #acc (1:18)\n | map(ArrayOfInt, {#acc})\n | .................^"},
+	}
+	for _, test := range errorTests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+}
+
+func TestCheck_errors(t *testing.T) {
+	type location struct {
+		line, column, endLine, endColumn int
+	}
+	type test struct {
+		message  string
+		location location
+	}
+	var tests = []test{
+		{"unknown name Noo", location{1, 0, 1, 3}},
+		{"type *checker_test.foo has no field Not", location{1, 6, 1, 13}},
+		{"not enough arguments to call Fn", location{2, 2, 2, 24}},
+		{"unknown name Not", location{2, 5, 2, 8}},
+		{"invalid operation: matches (mismatched types string and int)", location{2, 10, 2, 23}},
+	}
+
+	tree, err := parser.Parse("Noo + Foo.Not +\n  Fn(Not, 'a' matches 1)")
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(mockEnv2{}))
+	require.Error(t, err)
+
+	errors, ok := err.(*file.Errors)
+	require.True(t, ok, "%T", err)
+	require.Len(t, errors.GetErrors(), len(tests))
+
+	for i, e := range errors.GetErrors() {
+		l := e.Location
+		assert.Equal(t, tests[i].message, e.Message)
+		assert.Equal(t, tests[i].location, location{l.Line(), l.Column(), l.EndLine(), l.EndColumn()}, e.Message)
+		assert.NotEmpty(t, e.Snippet, e.Message)
+	}
+}
+
 // Other helper types.
 
 type abc interface {
@@ -466,26 +739,34 @@ type EmbedPtr struct {
 type mockEnv2 struct {
 	Sub
 	*EmbedPtr
-	Abc        abc
-	Foo        *foo
-	ArrayOfFoo []*foo
-	Map        map[string]*foo
-	Any        interface{}
-	ArrayOfAny []interface{}
-	ManOfAny   map[string]interface{}
-	Fn         func(bool, int, string, interface{}) string
-	Bool       bool
-	Float      float64
-	Int64      int
-	Int        int
-	String     string
-	BoolPtr    *bool
-	FloatPtr   *float64
-	IntPtr     *int
-	StringPtr  *string
-	Foo2p      **foo
-	BoolFn     func() bool
-	NilFn      func()
+	Abc          abc
+	Foo          *foo
+	ArrayOfFoo   []*foo
+	Map          map[string]*foo
+	Any          interface{}
+	ArrayOfAny   []interface{}
+	ArrayOfInt   []int
+	ArrayOfFloat []float64
+	ManOfAny     map[string]interface{}
+	Fn           func(bool, int, string, interface{}) string
+	Bool         bool
+	Float        float64
+	Int64        int
+	Int          int
+	Int8         int8
+	Uint8        uint8
+	String       string
+	BoolPtr      *bool
+	FloatPtr     *float64
+	IntPtr       *int
+	StringPtr    *string
+	Foo2p        **foo
+	BoolFn       func() bool
+	NilFn        func()
+	SortBy       func([]*foo, func(*foo) interface{}) []*foo
+	Less         func(func(a, b int) bool) bool
+	Apply        func(func(int) int, int) int
+	Scale        func(func(int) float64, int) float64
 }
 
 func (p mockEnv2) Method(_ bar) int {
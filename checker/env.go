@@ -23,6 +23,16 @@ func Env(i interface{}) TypesTable {
 	return types
 }
 
+// Func reports if the name is a func of the env, which shadows builtin
+// with the same name.
+func (types TypesTable) Func(name string) bool {
+	f, ok := types[name]
+	if ok {
+		_, ok = funcType(f.Type)
+	}
+	return ok
+}
+
 func CreateTypesTable(i interface{}) TypesTable {
 	types := make(TypesTable)
 	v := reflect.ValueOf(i)
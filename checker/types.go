@@ -1,8 +1,11 @@
 package checker
 
 import (
-	"github.com/jakub-gawlas/expr/ast"
+	"math"
 	"reflect"
+	"time"
+
+	"github.com/jakub-gawlas/expr/ast"
 )
 
 var (
@@ -10,10 +13,14 @@ var (
 	boolType      = reflect.TypeOf(true)
 	integerType   = reflect.TypeOf(int(0))
 	floatType     = reflect.TypeOf(float64(0))
+	int64Type     = reflect.TypeOf(int64(0))
+	uint64Type    = reflect.TypeOf(uint64(0))
 	stringType    = reflect.TypeOf("")
 	arrayType     = reflect.TypeOf([]interface{}{})
 	mapType       = reflect.TypeOf(map[interface{}]interface{}{})
 	interfaceType = reflect.TypeOf(new(interface{})).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
 )
 
 func dereference(t reflect.Type) reflect.Type {
@@ -42,6 +49,40 @@ func isComparable(l, r reflect.Type) bool {
 	return false
 }
 
+// promotedType returns type of arithmetic operation result on numbers,
+// following promotion rules of the vm.
+func promotedType(l, r reflect.Type) reflect.Type {
+	if l == r {
+		return l
+	}
+	if isInterface(l) || isInterface(r) {
+		return interfaceType
+	}
+	l, r = dereference(l), dereference(r)
+	if l == r {
+		return l
+	}
+	switch {
+	case isFloatKind(l) || isFloatKind(r):
+		return floatType
+	case isUnsignedKind(l) && isUnsignedKind(r):
+		return uint64Type
+	}
+	return int64Type
+}
+
+func isFloatKind(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+func isUnsignedKind(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
 func isInterface(t reflect.Type) bool {
 	t = dereference(t)
 	if t != nil {
@@ -137,6 +178,56 @@ func isMap(t reflect.Type) bool {
 	return false
 }
 
+func isTime(t reflect.Type) bool {
+	return dereference(t) == timeType
+}
+
+func isDuration(t reflect.Type) bool {
+	return dereference(t) == durationType
+}
+
+// timeOperation returns type of the operation on times and durations, or
+// false if operands are not a time and a time or a duration, or a duration
+// and a number.
+func timeOperation(op string, l, r reflect.Type) (reflect.Type, bool) {
+	switch {
+	case isTime(l) && isTime(r):
+		switch op {
+		case "-":
+			return durationType, true
+		case "<", ">", "<=", ">=":
+			return boolType, true
+		}
+
+	case isTime(l) && isDuration(r):
+		if op == "+" || op == "-" {
+			return timeType, true
+		}
+
+	case isDuration(l) && isTime(r):
+		if op == "+" {
+			return timeType, true
+		}
+
+	case isDuration(l) && isNumber(r) && !isInterface(r):
+		switch op {
+		case "+", "-", "*", "/":
+			return durationType, true
+		}
+
+	case isNumber(l) && !isInterface(l) && isDuration(r):
+		switch op {
+		case "+", "-", "*":
+			return durationType, true
+		}
+	}
+	return nil, false
+}
+
+func isShift(op string) bool {
+	return op == "<<" || op == ">>"
+}
+
 func isStruct(t reflect.Type) bool {
 	t = dereference(t)
 	if t != nil {
@@ -159,6 +250,58 @@ func isFunc(t reflect.Type) bool {
 	return false
 }
 
+// unifiedType returns type of the result, which is one of two values.
+func unifiedType(t1, t2 reflect.Type) reflect.Type {
+	if t1 == nil && t2 != nil {
+		return t2
+	}
+	if t1 != nil && t2 == nil {
+		return t1
+	}
+	if t1 == nil && t2 == nil {
+		return nilType
+	}
+	// Type of the result is known only if both values have the same type,
+	// compiler relies on it to emit typed opcodes.
+	if t1 == t2 {
+		return t1
+	}
+	return interfaceType
+}
+
+func isNillable(t reflect.Type) bool {
+	if t == nil {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// isNilSafe reports if the node is a chain of accessors containing ?. accessor.
+func isNilSafe(node ast.Node) bool {
+	for {
+		switch n := node.(type) {
+		case *ast.PropertyNode:
+			if n.NilSafe {
+				return true
+			}
+			node = n.Node
+		case *ast.MethodNode:
+			if n.NilSafe {
+				return true
+			}
+			node = n.Node
+		case *ast.IndexNode:
+			node = n.Node
+		default:
+			return false
+		}
+	}
+}
+
 func fieldType(ntype reflect.Type, name string) (reflect.Type, bool) {
 	ntype = dereference(ntype)
 	if ntype != nil {
@@ -271,17 +414,94 @@ func funcType(ntype reflect.Type) (reflect.Type, bool) {
 type setVisitor struct {
 	ast.BaseVisitor
 	t reflect.Type
+	// values holds exact values of uncertain literals and of +, - and *
+	// operators on them, which aren't operands of another such operator.
+	values map[ast.Node]int
+	nodes  []ast.Node
 }
 
-func (v setVisitor) IntegerNode(node *ast.IntegerNode) {
+func (v *setVisitor) IntegerNode(node *ast.IntegerNode) {
 	if !node.Certain {
 		node.SetType(v.t)
 		node.Certain = true
+		v.set(node, node.Value)
+	}
+}
+
+func (v *setVisitor) UnaryNode(node *ast.UnaryNode) {
+	if x, ok := v.values[node.Node]; ok {
+		switch node.Operator {
+		case "-":
+			delete(v.values, node.Node)
+			v.set(node, -x)
+		case "+":
+			delete(v.values, node.Node)
+			v.set(node, x)
+		}
+	}
+}
+
+func (v *setVisitor) BinaryNode(node *ast.BinaryNode) {
+	x, ok1 := v.values[node.Left]
+	y, ok2 := v.values[node.Right]
+	if !ok1 || !ok2 {
+		return
+	}
+	switch node.Operator {
+	case "+":
+		v.absorb(node, x+y)
+	case "-":
+		v.absorb(node, x-y)
+	case "*":
+		v.absorb(node, x*y)
 	}
 }
 
-func setUncertainType(node ast.Node, t reflect.Type) {
-	ast.Walk(node, setVisitor{t: dereference(t)})
+func (v *setVisitor) set(node ast.Node, value int) {
+	v.values[node] = value
+	v.nodes = append(v.nodes, node)
+}
+
+func (v *setVisitor) absorb(node *ast.BinaryNode, value int) {
+	delete(v.values, node.Left)
+	delete(v.values, node.Right)
+	v.set(node, value)
+}
+
+// setUncertainType sets type of integer literals, which type isn't certain yet.
+// Integers wrap around on +, - and *, so the exact value of such operators on
+// literals must fit the type, as well as literals used by other operators,
+// otherwise they would be silently truncated at runtime.
+func (v *visitor) setUncertainType(node ast.Node, t reflect.Type) {
+	s := &setVisitor{t: dereference(t), values: map[ast.Node]int{}}
+	ast.Walk(node, s)
+	for _, n := range s.nodes {
+		if value, ok := s.values[n]; ok && !fits(value, s.t.Kind()) {
+			v.error(n, "constant %v overflows %v", value, s.t)
+			return
+		}
+	}
+}
+
+// fits reports if the integer can be represented by a number of the kind.
+func fits(value int, kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int8:
+		return value >= math.MinInt8 && value <= math.MaxInt8
+	case reflect.Int16:
+		return value >= math.MinInt16 && value <= math.MaxInt16
+	case reflect.Int32:
+		return value >= math.MinInt32 && value <= math.MaxInt32
+	case reflect.Uint8:
+		return value >= 0 && value <= math.MaxUint8
+	case reflect.Uint16:
+		return value >= 0 && value <= math.MaxUint16
+	case reflect.Uint32:
+		return value >= 0 && uint64(value) <= math.MaxUint32
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return value >= 0
+	}
+	return true
 }
 
 type hasVisitor struct {
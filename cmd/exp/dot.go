@@ -4,6 +4,7 @@ import (
 	"fmt"
 	. "github.com/jakub-gawlas/expr/ast"
 	"os"
+	"strings"
 )
 
 const format = `digraph {
@@ -62,6 +63,10 @@ func (v *visitor) FloatNode(node *FloatNode) {
 	v.push(fmt.Sprintf("%v", node.Value))
 }
 
+func (v *visitor) DurationNode(node *DurationNode) {
+	v.push(node.Value.String())
+}
+
 func (v *visitor) BoolNode(node *BoolNode) {
 	v.push(fmt.Sprintf("%v", node.Value))
 }
@@ -94,7 +99,11 @@ func (v *visitor) MatchesNode(node *MatchesNode) {
 
 func (v *visitor) PropertyNode(node *PropertyNode) {
 	a := v.pop()
-	v.push(fmt.Sprintf(".%v", node.Property))
+	if node.NilSafe {
+		v.push(fmt.Sprintf("?.%v", node.Property))
+	} else {
+		v.push(fmt.Sprintf(".%v", node.Property))
+	}
 	v.link(a)
 }
 
@@ -112,7 +121,11 @@ func (v *visitor) MethodNode(node *MethodNode) {
 		args = append(args, v.pop())
 	}
 	a := v.pop()
-	v.push(fmt.Sprintf(".%v(...)", node.Method))
+	if node.NilSafe {
+		v.push(fmt.Sprintf("?.%v(...)", node.Method))
+	} else {
+		v.push(fmt.Sprintf(".%v(...)", node.Method))
+	}
 	v.link(a)
 	for i := len(args) - 1; i >= 0; i-- {
 		v.link(args[i])
@@ -143,12 +156,16 @@ func (v *visitor) BuiltinNode(node *BuiltinNode) {
 
 func (v *visitor) ClosureNode(node *ClosureNode) {
 	a := v.pop()
-	v.push("func {...}")
+	if len(node.Params) > 0 {
+		v.push(fmt.Sprintf("func (%v) => ...", strings.Join(node.Params, ", ")))
+	} else {
+		v.push("func {...}")
+	}
 	v.link(a)
 }
 
 func (v *visitor) PointerNode(node *PointerNode) {
-	v.push("#")
+	v.push("#" + node.Name)
 }
 
 func (v *visitor) ConditionalNode(node *ConditionalNode) {
@@ -188,3 +205,15 @@ func (v *visitor) PairNode(node *PairNode) {
 	v.push(fmt.Sprintf("%q:", node.Key.(*StringNode).Value))
 	v.link(a)
 }
+
+func (v *visitor) LetNode(node *LetNode) {
+	b := v.pop()
+	a := v.pop()
+	v.push(fmt.Sprintf("let %v", node.Name))
+	v.link(a)
+	v.link(b)
+}
+
+func (v *visitor) ConstantNode(node *ConstantNode) {
+	v.push(fmt.Sprintf("%v", node.Value))
+}
@@ -4,13 +4,18 @@ import (
 	"encoding/binary"
 	"fmt"
 	"github.com/jakub-gawlas/expr/ast"
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/builtin"
+	"github.com/jakub-gawlas/expr/file"
 	"github.com/jakub-gawlas/expr/parser"
 	. "github.com/jakub-gawlas/expr/vm"
 	"math"
 	"reflect"
 )
 
+// maxConstantRange is the size of the biggest range created at compile time,
+// bigger ranges are created at runtime, where they are subject to budget limits.
+const maxConstantRange = math.MaxUint16
+
 func Compile(tree *parser.Tree, ops ...OptionFn) (program *Program, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -19,14 +24,26 @@ func Compile(tree *parser.Tree, ops ...OptionFn) (program *Program, err error) {
 	}()
 
 	c := &compiler{
-		index: make(map[interface{}]uint16),
+		index:    make(map[interface{}]uint16),
+		optimize: true,
 	}
 
 	for _, op := range ops {
 		op(c)
 	}
 
-	c.compile(tree.Node)
+	// Builtin calls of checked trees are resolved by the checker, which sets
+	// types of all nodes. Without the checker env funcs are known only if
+	// set with Funcs, otherwise calls of funcs named like builtins are
+	// builtin calls.
+	node := builtin.Resolve(tree.Node, func(node *ast.FunctionNode) bool {
+		return node.GetType() != nil || c.funcs != nil && c.funcs(node.Name)
+	})
+	if c.optimize {
+		node = optimize(node)
+	}
+
+	c.compile(node)
 
 	program = &Program{
 		Source:    tree.Source,
@@ -38,12 +55,31 @@ func Compile(tree *parser.Tree, ops ...OptionFn) (program *Program, err error) {
 }
 
 type compiler struct {
-	locations   []helper.Location
+	locations   []file.Location
 	constants   []interface{}
 	bytecode    []byte
 	index       map[interface{}]uint16
 	mapEnv      bool
+	optimize    bool
+	funcs       func(name string) bool
 	currentNode ast.Node
+	// receiver is set while compiling receiver of a member accessor.
+	receiver bool
+	// nilJumps are jumps of ?. accessors to the end of the current chain.
+	nilJumps []int
+	// coalesce is set while compiling accessors chain which is
+	// the left operand of ??.
+	coalesce bool
+	// variables are let bindings in scope, the innermost are the last.
+	variables []variable
+	slots     int
+	// pointer is slot of # within closure passed to a func.
+	pointer []byte
+}
+
+type variable struct {
+	name string
+	slot []byte
 }
 
 // OptionFn for configuring expr.
@@ -55,6 +91,22 @@ func MapEnv() OptionFn {
 	}
 }
 
+// Funcs sets func, which reports if the env of unchecked tree defines
+// func with the name. Such funcs shadow builtins with the same name.
+func Funcs(defined func(name string) bool) OptionFn {
+	return func(c *compiler) {
+		c.funcs = defined
+	}
+}
+
+// Optimize enables or disables folding of constant expressions
+// before compilation. Enabled by default.
+func Optimize(enabled bool) OptionFn {
+	return func(c *compiler) {
+		c.optimize = enabled
+	}
+}
+
 func (c *compiler) emit(op byte, b ...byte) int {
 	c.bytecode = append(c.bytecode, op)
 	current := len(c.bytecode)
@@ -68,11 +120,7 @@ func (c *compiler) emit(op byte, b ...byte) int {
 }
 
 func (c *compiler) makeConstant(i interface{}) []byte {
-	hashable := true
-	switch reflect.TypeOf(i).Kind() {
-	case reflect.Slice:
-		hashable = false
-	}
+	hashable := reflect.TypeOf(i).Comparable()
 
 	if hashable {
 		if p, ok := c.index[i]; ok {
@@ -108,6 +156,11 @@ func (c *compiler) calcBackwardJump(to int) []byte {
 }
 
 func (c *compiler) compile(node ast.Node) {
+	// Restore current node after compiling children,
+	// so instructions emitted by parent refer to parent's location.
+	parent := c.currentNode
+	defer func() { c.currentNode = parent }()
+
 	c.currentNode = node
 	switch n := node.(type) {
 	case *ast.NilNode:
@@ -118,6 +171,8 @@ func (c *compiler) compile(node ast.Node) {
 		c.IntegerNode(n)
 	case *ast.FloatNode:
 		c.FloatNode(n)
+	case *ast.DurationNode:
+		c.DurationNode(n)
 	case *ast.BoolNode:
 		c.BoolNode(n)
 	case *ast.StringNode:
@@ -148,20 +203,69 @@ func (c *compiler) compile(node ast.Node) {
 		c.ArrayNode(n)
 	case *ast.MapNode:
 		c.MapNode(n)
+	case *ast.LetNode:
+		c.LetNode(n)
+	case *ast.ConstantNode:
+		c.ConstantNode(n)
 	default:
 		panic(fmt.Sprintf("undefined node type (%T)", node))
 	}
 }
 
+// LetNode stores value in a slot, so it's computed only once.
+func (c *compiler) LetNode(node *ast.LetNode) {
+	c.compile(node.Value)
+	c.declare(node.Name)
+	c.compile(node.Node)
+	c.variables = c.variables[:len(c.variables)-1]
+}
+
+// declare stores value on top of the stack in a new slot and
+// binds it to the name.
+func (c *compiler) declare(name string) {
+	slot := c.slot()
+	c.emit(OpStoreSlot, slot...)
+
+	c.variables = append(c.variables, variable{name: name, slot: slot})
+}
+
+// slot allocates a new slot, which is used only by a single binding.
+func (c *compiler) slot() []byte {
+	if c.slots > math.MaxUint16 {
+		panic("exceeded variables max space limit")
+	}
+	slot := encode(uint16(c.slots))
+	c.slots++
+	return slot
+}
+
+func (c *compiler) ConstantNode(node *ast.ConstantNode) {
+	if node.Value == nil {
+		c.emit(OpNil)
+		return
+	}
+	c.emit(OpConst, c.makeConstant(node.Value)...)
+}
+
 func (c *compiler) NilNode(node *ast.NilNode) {
 	c.emit(OpNil)
 }
 
 func (c *compiler) IdentifierNode(node *ast.IdentifierNode) {
+	for i := len(c.variables) - 1; i >= 0; i-- {
+		if c.variables[i].name == node.Value {
+			c.emit(OpLoadSlot, c.variables[i].slot...)
+			return
+		}
+	}
+
 	v := c.makeConstant(node.Value)
-	if c.mapEnv {
+	switch {
+	case c.mapEnv:
 		c.emit(OpFetchMap, v...)
-	} else {
+	case c.coalesce:
+		c.emit(OpFetchOrNil, v...)
+	default:
 		c.emit(OpFetch, v...)
 	}
 }
@@ -169,7 +273,7 @@ func (c *compiler) IdentifierNode(node *ast.IdentifierNode) {
 func (c *compiler) IntegerNode(node *ast.IntegerNode) {
 	t := node.GetType()
 	if t == nil {
-		if node.Value <= math.MaxUint16 {
+		if node.Value >= 0 && node.Value <= math.MaxUint16 {
 			c.emit(OpPush, encode(uint16(node.Value))...)
 		} else {
 			c.emit(OpConst, c.makeConstant(node.Value)...)
@@ -184,7 +288,7 @@ func (c *compiler) IntegerNode(node *ast.IntegerNode) {
 		c.emit(OpConst, c.makeConstant(float64(node.Value))...)
 
 	case reflect.Int:
-		if node.Value <= math.MaxUint16 {
+		if node.Value >= 0 && node.Value <= math.MaxUint16 {
 			c.emit(OpPush, encode(uint16(node.Value))...)
 		} else {
 			c.emit(OpConst, c.makeConstant(node.Value)...)
@@ -218,6 +322,10 @@ func (c *compiler) FloatNode(node *ast.FloatNode) {
 	c.emit(OpConst, c.makeConstant(node.Value)...)
 }
 
+func (c *compiler) DurationNode(node *ast.DurationNode) {
+	c.emit(OpConst, c.makeConstant(node.Value)...)
+}
+
 func (c *compiler) BoolNode(node *ast.BoolNode) {
 	if node.Value {
 		c.emit(OpTrue)
@@ -244,6 +352,9 @@ func (c *compiler) UnaryNode(node *ast.UnaryNode) {
 	case "-":
 		c.emit(OpNegate)
 
+	case "~":
+		c.emit(OpBitNot)
+
 	default:
 		panic(fmt.Sprintf("unknown operator (%v)", node.Operator))
 	}
@@ -255,20 +366,12 @@ func (c *compiler) BinaryNode(node *ast.BinaryNode) {
 	case "==":
 		c.compile(node.Left)
 		c.compile(node.Right)
-
-		l := kind(node.Left)
-		r := kind(node.Right)
-
-		if l == reflect.String && r == reflect.String {
-			c.emit(OpEqualString)
-		} else {
-			c.emit(OpEqual)
-		}
+		c.emitTyped(node, OpEqual, OpEqualInt, OpEqualFloat, OpEqualString)
 
 	case "!=":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpEqual)
+		c.emitTyped(node, OpEqual, OpEqualInt, OpEqualFloat, OpEqualString)
 		c.emit(OpNot)
 
 	case "or", "||":
@@ -285,67 +388,104 @@ func (c *compiler) BinaryNode(node *ast.BinaryNode) {
 		c.compile(node.Right)
 		c.patchJump(end)
 
-	case "in":
+	case "??":
+		c.coalesce = isChain(node.Left) || isIdentifier(node.Left)
 		c.compile(node.Left)
+		c.coalesce = false
+		end := c.emit(OpJumpIfNotNil, c.placeholder()...)
+		c.emit(OpPop)
 		c.compile(node.Right)
-		c.emit(OpIn)
+		c.patchJump(end)
+
+	case "in":
+		c.compile(node.Left)
+		c.emitIn(node.Right)
 
 	case "not in":
 		c.compile(node.Left)
-		c.compile(node.Right)
-		c.emit(OpIn)
+		c.emitIn(node.Right)
 		c.emit(OpNot)
 
 	case "<":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpLess)
+		c.emitTyped(node, OpLess, OpLessInt, OpLessFloat, OpLess)
 
 	case ">":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpMore)
+		c.emitTyped(node, OpMore, OpMoreInt, OpMoreFloat, OpMore)
 
 	case ">=":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpMoreOrEqual)
+		c.emitTyped(node, OpMoreOrEqual, OpMoreOrEqualInt, OpMoreOrEqualFloat, OpMoreOrEqual)
 
 	case "<=":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpLessOrEqual)
+		c.emitTyped(node, OpLessOrEqual, OpLessOrEqualInt, OpLessOrEqualFloat, OpLessOrEqual)
 
 	case "+":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpAdd)
+		c.emitTyped(node, OpAdd, OpAddInt, OpAddFloat, OpAddString)
 
 	case "-":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpSubtract)
+		c.emitTyped(node, OpSubtract, OpSubtractInt, OpSubtractFloat, OpSubtract)
 
 	case "*":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpMultiply)
+		c.emitTyped(node, OpMultiply, OpMultiplyInt, OpMultiplyFloat, OpMultiply)
 
 	case "/":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpDivide)
+		c.emitTyped(node, OpDivide, OpDivideInt, OpDivideFloat, OpDivide)
 
 	case "%":
 		c.compile(node.Left)
 		c.compile(node.Right)
-		c.emit(OpModulo)
+		c.emitTyped(node, OpModulo, OpModuloInt, OpModulo, OpModulo)
 
 	case "**":
 		c.compile(node.Left)
 		c.compile(node.Right)
 		c.emit(OpExponent)
 
+	case "&":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpBitAnd)
+
+	case "|":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpBitOr)
+
+	case "^":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpBitXor)
+
+	case "&^":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpBitAndNot)
+
+	case "<<":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpShiftLeft)
+
+	case ">>":
+		c.compile(node.Left)
+		c.compile(node.Right)
+		c.emit(OpShiftRight)
+
 	case "contains":
 		c.compile(node.Left)
 		c.compile(node.Right)
@@ -364,12 +504,19 @@ func (c *compiler) BinaryNode(node *ast.BinaryNode) {
 	case "..":
 		min, ok1 := node.Left.(*ast.IntegerNode)
 		max, ok2 := node.Right.(*ast.IntegerNode)
+		size := 0
 		if ok1 && ok2 {
+			size = max.Value - min.Value + 1
+		}
+		if size > 0 && size <= maxConstantRange {
 			// Create range on compile time to avoid unnecessary work at runtime.
-			size := max.Value - min.Value + 1
+			kind := reflect.Int
+			if t := node.Left.GetType(); t != nil {
+				kind = t.Kind()
+			}
 			rng := make([]interface{}, size)
 			for i := range rng {
-				switch node.Left.GetType().Kind() {
+				switch kind {
 				case reflect.Int:
 					rng[i] = int(min.Value + i)
 				case reflect.Int8:
@@ -406,6 +553,89 @@ func (c *compiler) BinaryNode(node *ast.BinaryNode) {
 	}
 }
 
+var (
+	intType    = reflect.TypeOf(0)
+	floatType  = reflect.TypeOf(float64(0))
+	stringType = reflect.TypeOf("")
+)
+
+// emitTyped emits specialized opcode if both operands are known to be
+// int, float64 or string, and generic opcode otherwise.
+func (c *compiler) emitTyped(node *ast.BinaryNode, generic, intOp, floatOp, stringOp byte) {
+	t := node.Left.GetType()
+	if t != node.Right.GetType() {
+		c.emit(generic)
+		return
+	}
+	switch t {
+	case intType:
+		c.emit(intOp)
+	case floatType:
+		c.emit(floatOp)
+	case stringType:
+		c.emit(stringOp)
+	default:
+		c.emit(generic)
+	}
+}
+
+// emitIn emits membership check. Constant arrays are compiled to sets
+// and constant ranges to bounds checks.
+func (c *compiler) emitIn(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.ConstantNode:
+		if array, ok := n.Value.([]interface{}); ok {
+			if set, ok := NewSet(array); ok {
+				c.emit(OpInSet, c.makeConstant(set)...)
+				return
+			}
+		}
+
+	case *ast.ArrayNode:
+		if array, ok := constantArray(n); ok {
+			if set, ok := NewSet(array); ok {
+				c.emit(OpInSet, c.makeConstant(set)...)
+				return
+			}
+		}
+
+	case *ast.BinaryNode:
+		min, ok1 := n.Left.(*ast.IntegerNode)
+		max, ok2 := n.Right.(*ast.IntegerNode)
+		if n.Operator == ".." && ok1 && ok2 {
+			c.emit(OpInRange, c.makeConstant(Range{Min: min.Value, Max: max.Value})...)
+			return
+		}
+	}
+
+	c.compile(node)
+	c.emit(OpIn)
+}
+
+// constantArray returns values of array literal if all its items are literals.
+func constantArray(node *ast.ArrayNode) ([]interface{}, bool) {
+	array := make([]interface{}, len(node.Nodes))
+	for i, item := range node.Nodes {
+		switch n := item.(type) {
+		case *ast.IntegerNode:
+			array[i] = n.Value
+		case *ast.FloatNode:
+			array[i] = n.Value
+		case *ast.DurationNode:
+			array[i] = n.Value
+		case *ast.StringNode:
+			array[i] = n.Value
+		case *ast.BoolNode:
+			array[i] = n.Value
+		case *ast.ConstantNode:
+			array[i] = n.Value
+		default:
+			return nil, false
+		}
+	}
+	return array, true
+}
+
 func (c *compiler) MatchesNode(node *ast.MatchesNode) {
 	if node.Regexp != nil {
 		c.compile(node.Left)
@@ -418,131 +648,197 @@ func (c *compiler) MatchesNode(node *ast.MatchesNode) {
 }
 
 func (c *compiler) PropertyNode(node *ast.PropertyNode) {
-	c.compile(node.Node)
-	c.emit(OpProperty, c.makeConstant(node.Property)...)
+	c.chain(node.Node, node.NilSafe, func(coalesce bool) {
+		if coalesce {
+			c.emit(OpPropertyOrNil, c.makeConstant(node.Property)...)
+		} else {
+			c.emit(OpProperty, c.makeConstant(node.Property)...)
+		}
+	})
 }
 
 func (c *compiler) IndexNode(node *ast.IndexNode) {
-	c.compile(node.Node)
-	c.compile(node.Index)
-	c.emit(OpIndex)
+	c.chain(node.Node, false, func(coalesce bool) {
+		c.compile(node.Index)
+		if coalesce {
+			c.emit(OpIndexOrNil)
+		} else {
+			c.emit(OpIndex)
+		}
+	})
 }
 
 func (c *compiler) MethodNode(node *ast.MethodNode) {
-	c.compile(node.Node)
-	for _, arg := range node.Arguments {
-		c.compile(arg)
+	c.chain(node.Node, node.NilSafe, func(bool) {
+		c.arguments(node.Arguments)
+		c.emit(OpMethod, c.makeConstant(Call{Name: node.Method, Size: len(node.Arguments)})...)
+	})
+}
+
+// chain compiles receiver of a member accessor followed by the accessor.
+// If receiver of ?. accessor is nil, evaluation jumps to the end of the
+// outermost accessor, so the whole chain a?.b.c results in nil. Left
+// operand of ?? is compiled as if all accessors were ?. ones, and
+// missing map keys and env variables result in nil as well.
+func (c *compiler) chain(receiver ast.Node, nilSafe bool, accessor func(coalesce bool)) {
+	outermost := !c.receiver
+	var jumps []int
+	if outermost {
+		jumps = c.nilJumps
+		c.nilJumps = nil
+	}
+	coalesce := c.coalesce
+
+	c.receiver = isChain(receiver)
+	c.coalesce = coalesce && (c.receiver || isIdentifier(receiver))
+	c.compile(receiver)
+	c.receiver = false
+	c.coalesce = false
+
+	if nilSafe || coalesce {
+		c.nilJumps = append(c.nilJumps, c.emit(OpJumpIfNil, c.placeholder()...))
+	}
+	accessor(coalesce)
+
+	if outermost {
+		for _, jump := range c.nilJumps {
+			c.patchJump(jump)
+		}
+		c.nilJumps = jumps
+	}
+}
+
+// isIdentifier reports if the node is a variable of the env.
+func isIdentifier(node ast.Node) bool {
+	_, ok := node.(*ast.IdentifierNode)
+	return ok
+}
+
+// isChain reports if the node is a member accessor.
+func isChain(node ast.Node) bool {
+	switch node.(type) {
+	case *ast.PropertyNode, *ast.IndexNode, *ast.MethodNode:
+		return true
 	}
-	c.emit(OpMethod, c.makeConstant(Call{Name: node.Method, Size: len(node.Arguments)})...)
+	return false
 }
 
 func (c *compiler) FunctionNode(node *ast.FunctionNode) {
-	for _, arg := range node.Arguments {
+	c.arguments(node.Arguments)
+	c.emit(OpCall, c.makeConstant(Call{Name: node.Name, Size: len(node.Arguments)})...)
+}
+
+// arguments compiles arguments of a func or method call,
+// closures among them are compiled to funcs.
+func (c *compiler) arguments(nodes []ast.Node) {
+	for _, arg := range nodes {
+		if closure, ok := arg.(*ast.ClosureNode); ok {
+			c.function(closure)
+			continue
+		}
 		c.compile(arg)
 	}
-	c.emit(OpCall, c.makeConstant(Call{Name: node.Name, Size: len(node.Arguments)})...)
 }
 
-func (c *compiler) BuiltinNode(node *ast.BuiltinNode) {
-	switch node.Name {
-	case "len":
-		c.compile(node.Arguments[0])
-		c.emit(OpLen)
-
-	case "all":
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		var loopBreak int
-		c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-			loopBreak = c.emit(OpJumpIfFalse, c.placeholder()...)
-			c.emit(OpPop)
-		})
-		c.emit(OpTrue)
-		c.patchJump(loopBreak)
-		c.emit(OpEnd)
-
-	case "none":
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		var loopBreak int
-		c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-			c.emit(OpNot)
-			loopBreak = c.emit(OpJumpIfFalse, c.placeholder()...)
-			c.emit(OpPop)
-		})
-		c.emit(OpTrue)
-		c.patchJump(loopBreak)
-		c.emit(OpEnd)
-
-	case "any":
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		var loopBreak int
-		c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-			loopBreak = c.emit(OpJumpIfTrue, c.placeholder()...)
-			c.emit(OpPop)
-		})
-		c.emit(OpFalse)
-		c.patchJump(loopBreak)
-		c.emit(OpEnd)
-
-	case "one":
-		count := c.makeConstant("count")
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		c.emit(OpPush, encode(0)...)
-		c.emit(OpStore, count...)
-		c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-			c.emitCond(func() {
-				c.emit(OpLoad, count...)
-				c.emit(OpInc)
-				c.emit(OpStore, count...)
-			})
-		})
-		c.emit(OpLoad, count...)
-		c.emit(OpPush, encode(1)...)
-		c.emit(OpEqual)
-		c.emit(OpEnd)
-
-	case "filter":
-		count := c.makeConstant("count")
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		c.emit(OpPush, encode(0)...)
-		c.emit(OpStore, count...)
-		c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-			c.emitCond(func() {
-				c.emit(OpLoad, count...)
-				c.emit(OpInc)
-				c.emit(OpStore, count...)
-
-				c.emit(OpLoad, c.makeConstant("array")...)
-				c.emit(OpLoad, c.makeConstant("i")...)
-				c.emit(OpIndex)
-			})
-		})
-		c.emit(OpLoad, count...)
-		c.emit(OpEnd)
-		c.emit(OpArray)
-
-	case "map":
-		c.compile(node.Arguments[0])
-		c.emit(OpBegin)
-		size := c.emitLoop(func() {
-			c.compile(node.Arguments[1])
-		})
-		c.emit(OpLoad, size...)
-		c.emit(OpEnd)
-		c.emit(OpArray)
+// function compiles closure body in place, skipped by OpFunction, which
+// pushes func calling the body. Parameters are passed on the stack and
+// stored in slots, the first one is accessible with # as well.
+func (c *compiler) function(node *ast.ClosureNode) {
+	params := node.Params
+	if len(params) == 0 {
+		params = []string{"#"}
+	}
 
-	default:
+	c.emit(OpPush, encode(uint16(len(params)))...)
+	skip := c.emit(OpFunction, c.placeholder()...)
+
+	slots := make([][]byte, len(params))
+	for i := range params {
+		slots[i] = c.slot()
+	}
+	for i := len(params) - 1; i >= 0; i-- {
+		c.emit(OpStoreSlot, slots[i]...)
+	}
+
+	pointer := c.pointer
+	c.pointer = slots[0]
+	for i, name := range node.Params {
+		c.variables = append(c.variables, variable{name: name, slot: slots[i]})
+	}
+
+	c.compile(node.Node)
+
+	c.variables = c.variables[:len(c.variables)-len(node.Params)]
+	c.pointer = pointer
+
+	c.emit(OpReturn)
+	c.patchJump(skip)
+}
+
+func (c *compiler) BuiltinNode(node *ast.BuiltinNode) {
+	b, ok := builtin.Lookup(node.Name)
+	if !ok {
 		panic(fmt.Sprintf("unknown builtin %v", node.Name))
 	}
+	if b.Compile != nil {
+		b.Compile(c, node)
+		return
+	}
+	c.arguments(node.Arguments)
+	call := BuiltinCall{Name: node.Name, Size: len(node.Arguments), Func: b.Func, StringSize: b.StringSize}
+	c.emit(OpBuiltin, c.makeConstant(call)...)
+}
+
+// Compile, Emit, Constant, Placeholder, PatchJump, Slot, EmitLoop,
+// EmitItem, EmitIndex, EmitCond and Bind implement builtin.Compiler
+// for compile hooks.
+
+func (c *compiler) Compile(node ast.Node) {
+	c.compile(node)
+}
+
+func (c *compiler) Emit(op byte, arg ...byte) int {
+	return c.emit(op, arg...)
+}
+
+func (c *compiler) Constant(value interface{}) []byte {
+	return c.makeConstant(value)
+}
+
+func (c *compiler) Placeholder() []byte {
+	return c.placeholder()
+}
+
+func (c *compiler) PatchJump(position int) {
+	c.patchJump(position)
+}
+
+func (c *compiler) Slot() []byte {
+	return c.slot()
+}
+
+func (c *compiler) EmitLoop(body func()) []byte {
+	return c.emitLoop(body)
+}
+
+func (c *compiler) EmitItem() {
+	c.emit(OpLoad, c.makeConstant("array")...)
+	c.emit(OpLoad, c.makeConstant("i")...)
+	c.emit(OpIndex)
+}
+
+func (c *compiler) EmitIndex() {
+	c.emit(OpLoad, c.makeConstant("i")...)
+}
+
+func (c *compiler) EmitCond(body func()) {
+	c.emitCond(body)
+}
+
+func (c *compiler) Bind(name string, slot []byte, body func()) {
+	c.variables = append(c.variables, variable{name: "#" + name, slot: slot})
+	body()
+	c.variables = c.variables[:len(c.variables)-1]
 }
 
 func (c *compiler) emitCond(body func()) {
@@ -562,9 +858,10 @@ func (c *compiler) emitLoop(body func()) []byte {
 	size := c.makeConstant("size")
 	array := c.makeConstant("array")
 
+	c.emit(OpStore, array...)
+	c.emit(OpLoad, array...)
 	c.emit(OpLen)
 	c.emit(OpStore, size...)
-	c.emit(OpStore, array...)
 	c.emit(OpPush, encode(0)...)
 	c.emit(OpStore, i...)
 
@@ -588,14 +885,35 @@ func (c *compiler) emitLoop(body func()) []byte {
 	return size
 }
 
+// ClosureNode binds named parameters to slots, so they are accessible
+// within nested closures, where # refers to the inner element.
 func (c *compiler) ClosureNode(node *ast.ClosureNode) {
+	pointer := c.pointer
+	c.pointer = nil
+	defer func() { c.pointer = pointer }()
+
+	if len(node.Params) > 0 {
+		c.EmitItem()
+		c.declare(node.Params[0])
+		if len(node.Params) > 1 {
+			c.EmitIndex()
+			c.declare(node.Params[1])
+		}
+	}
 	c.compile(node.Node)
+	c.variables = c.variables[:len(c.variables)-len(node.Params)]
 }
 
 func (c *compiler) PointerNode(node *ast.PointerNode) {
-	c.emit(OpLoad, c.makeConstant("array")...)
-	c.emit(OpLoad, c.makeConstant("i")...)
-	c.emit(OpIndex)
+	if node.Name != "" {
+		c.IdentifierNode(&ast.IdentifierNode{Value: "#" + node.Name})
+		return
+	}
+	if c.pointer != nil {
+		c.emit(OpLoadSlot, c.pointer...)
+		return
+	}
+	c.EmitItem()
 }
 
 func (c *compiler) ConditionalNode(node *ast.ConditionalNode) {
@@ -645,11 +963,3 @@ func encode(i uint16) []byte {
 	binary.LittleEndian.PutUint16(b, i)
 	return b
 }
-
-func kind(node ast.Node) reflect.Kind {
-	t := node.GetType()
-	if t == nil {
-		return reflect.Invalid
-	}
-	return t.Kind()
-}
@@ -1,6 +1,7 @@
 package compiler_test
 
 import (
+	"github.com/jakub-gawlas/expr/checker"
 	"github.com/jakub-gawlas/expr/compiler"
 	"github.com/jakub-gawlas/expr/parser"
 	"github.com/jakub-gawlas/expr/vm"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"math"
 	"testing"
+	"time"
 )
 
 func TestCompile_debug(t *testing.T) {
@@ -83,6 +85,43 @@ func TestCompile(t *testing.T) {
 				},
 			},
 		},
+		{
+			`A?.B.C`,
+			vm.Program{
+				Constants: []interface{}{
+					"A",
+					"B",
+					"C",
+				},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpJumpIfNil, 6, 0,
+					vm.OpProperty, 1, 0,
+					vm.OpProperty, 2, 0,
+				},
+			},
+		},
+		{
+			`A.B?.C(D?.E)`,
+			vm.Program{
+				Constants: []interface{}{
+					"A",
+					"B",
+					"D",
+					"E",
+					vm.Call{Name: "C", Size: 1},
+				},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpProperty, 1, 0,
+					vm.OpJumpIfNil, 12, 0,
+					vm.OpFetch, 2, 0,
+					vm.OpJumpIfNil, 3, 0,
+					vm.OpProperty, 3, 0,
+					vm.OpMethod, 4, 0,
+				},
+			},
+		},
 		{
 			`"string"`,
 			vm.Program{
@@ -129,6 +168,33 @@ func TestCompile(t *testing.T) {
 				},
 			},
 		},
+		{
+			`Name in ["a", "b"]`,
+			vm.Program{
+				Constants: []interface{}{
+					"Name",
+					vm.Set{"a": {}, "b": {}},
+				},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpInSet, 1, 0,
+				},
+			},
+		},
+		{
+			`Name not in 1..3`,
+			vm.Program{
+				Constants: []interface{}{
+					"Name",
+					vm.Range{Min: 1, Max: 3},
+				},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpInRange, 1, 0,
+					vm.OpNot,
+				},
+			},
+		},
 		{
 			`true && true || true`,
 			vm.Program{
@@ -143,12 +209,254 @@ func TestCompile(t *testing.T) {
 				},
 			},
 		},
+		{
+			`Fn((a, b) => a + #)`,
+			vm.Program{
+				Constants: []interface{}{
+					vm.Call{Name: "Fn", Size: 1},
+				},
+				Bytecode: []byte{
+					vm.OpPush, 2, 0,
+					vm.OpFunction, 14, 0,
+					vm.OpStoreSlot, 1, 0,
+					vm.OpStoreSlot, 0, 0,
+					vm.OpLoadSlot, 0, 0,
+					vm.OpLoadSlot, 0, 0,
+					vm.OpAdd,
+					vm.OpReturn,
+					vm.OpCall, 0, 0,
+				},
+			},
+		},
+		{
+			`let x = 1; let y = 2; (let x = 3; x + y) + x`,
+			vm.Program{
+				Bytecode: []byte{
+					vm.OpPush, 1, 0,
+					vm.OpStoreSlot, 0, 0,
+					vm.OpPush, 2, 0,
+					vm.OpStoreSlot, 1, 0,
+					vm.OpPush, 3, 0,
+					vm.OpStoreSlot, 2, 0,
+					vm.OpLoadSlot, 2, 0,
+					vm.OpLoadSlot, 1, 0,
+					vm.OpAdd,
+					vm.OpLoadSlot, 0, 0,
+					vm.OpAdd,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		node, err := parser.Parse(test.input)
 		require.NoError(t, err)
 
+		program, err := compiler.Compile(node, compiler.Optimize(false))
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.program.Disassemble(), program.Disassemble(), test.input)
+	}
+}
+
+func TestCompile_optimize(t *testing.T) {
+	type test struct {
+		input   string
+		program vm.Program
+	}
+	var tests = []test{
+		{
+			`2 ** 8`,
+			vm.Program{
+				Constants: []interface{}{float64(256)},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`"a" + "b" == "ab"`,
+			vm.Program{
+				Bytecode: []byte{vm.OpTrue},
+			},
+		},
+		{
+			`-1`,
+			vm.Program{
+				Constants: []interface{}{-1},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`1..(1 + 2)`,
+			vm.Program{
+				Constants: []interface{}{[]interface{}{1, 2, 3}},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`len(1..3)`,
+			vm.Program{
+				Bytecode: []byte{vm.OpPush, 3, 0},
+			},
+		},
+		{
+			`[1..2, 3]`,
+			vm.Program{
+				Constants: []interface{}{[]interface{}{[]interface{}{1, 2}, 3}},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`Foo in 1..(1 + 2)`,
+			vm.Program{
+				Constants: []interface{}{"Foo", vm.Range{Min: 1, Max: 3}},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpInRange, 1, 0,
+				},
+			},
+		},
+		{
+			`1h + 30m * 2`,
+			vm.Program{
+				Constants: []interface{}{2 * time.Hour},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`[1, 2 * 3]`,
+			vm.Program{
+				Constants: []interface{}{[]interface{}{1, 6}},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`{a: "b" + "c"}`,
+			vm.Program{
+				Constants: []interface{}{map[string]interface{}{"a": "bc"}},
+				Bytecode:  []byte{vm.OpConst, 0, 0},
+			},
+		},
+		{
+			`1 > 2 ? Foo : Bar`,
+			vm.Program{
+				Constants: []interface{}{"Bar"},
+				Bytecode:  []byte{vm.OpFetch, 0, 0},
+			},
+		},
+		{
+			`not true && Foo`,
+			vm.Program{
+				Bytecode: []byte{vm.OpFalse},
+			},
+		},
+		{
+			`false || Foo`,
+			vm.Program{
+				Constants: []interface{}{"Foo"},
+				Bytecode:  []byte{vm.OpFetch, 0, 0},
+			},
+		},
+		{
+			`Foo matches "^" + "a"`,
+			vm.Program{
+				Constants: []interface{}{"Foo", "^a"},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpMatchesConst, 1, 0,
+				},
+			},
+		},
+		{
+			`1 / 0`,
+			vm.Program{
+				Bytecode: []byte{
+					vm.OpPush, 1, 0,
+					vm.OpPush, 0, 0,
+					vm.OpDivide,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		node, err := parser.Parse(test.input)
+		require.NoError(t, err)
+
+		program, err := compiler.Compile(node)
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.program.Disassemble(), program.Disassemble(), test.input)
+	}
+}
+
+func TestCompile_typed(t *testing.T) {
+	type env struct {
+		Int    int
+		Float  float64
+		String string
+		Any    interface{}
+	}
+	type test struct {
+		input   string
+		program vm.Program
+	}
+	var tests = []test{
+		{
+			`Int + 1`,
+			vm.Program{
+				Constants: []interface{}{"Int"},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpPush, 1, 0,
+					vm.OpAddInt,
+				},
+			},
+		},
+		{
+			`Float < 1`,
+			vm.Program{
+				Constants: []interface{}{"Float", float64(1)},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpConst, 1, 0,
+					vm.OpLessFloat,
+				},
+			},
+		},
+		{
+			`String + String != "a"`,
+			vm.Program{
+				Constants: []interface{}{"String", "a"},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpFetch, 0, 0,
+					vm.OpAddString,
+					vm.OpConst, 1, 0,
+					vm.OpEqualString,
+					vm.OpNot,
+				},
+			},
+		},
+		{
+			`Int * Any`,
+			vm.Program{
+				Constants: []interface{}{"Int", "Any"},
+				Bytecode: []byte{
+					vm.OpFetch, 0, 0,
+					vm.OpFetch, 1, 0,
+					vm.OpMultiply,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		node, err := parser.Parse(test.input)
+		require.NoError(t, err)
+
+		_, err = checker.Check(node, checker.Env(&env{}))
+		require.NoError(t, err, test.input)
+
 		program, err := compiler.Compile(node)
 		require.NoError(t, err, test.input)
 
@@ -0,0 +1,211 @@
+package compiler
+
+import (
+	"math"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/jakub-gawlas/expr/ast"
+	. "github.com/jakub-gawlas/expr/vm"
+)
+
+// optimize folds constant subtrees of the node and eliminates branches
+// with constant conditions. Constant subtrees are evaluated by the vm,
+// so folded values are the same as computed at runtime. If evaluation
+// fails, subtree is left as is to report the error at runtime.
+func optimize(node ast.Node) ast.Node {
+	switch n := node.(type) {
+	case *ast.UnaryNode:
+		n.Node = optimize(n.Node)
+		if isConstant(n.Node) {
+			return fold(n)
+		}
+
+	case *ast.BinaryNode:
+		n.Left = optimize(n.Left)
+		if r, ok := n.Right.(*ast.BinaryNode); ok && r.Operator == ".." && (n.Operator == "in" || n.Operator == "not in") {
+			// Membership in a range is compiled to bounds check, so the range is kept.
+			r.Left = optimize(r.Left)
+			r.Right = optimize(r.Right)
+			return n
+		}
+		n.Right = optimize(n.Right)
+
+		switch n.Operator {
+		case "and", "&&":
+			if b, ok := n.Left.(*ast.BoolNode); ok {
+				if !b.Value {
+					return n.Left
+				}
+				return n.Right
+			}
+
+		case "or", "||":
+			if b, ok := n.Left.(*ast.BoolNode); ok {
+				if b.Value {
+					return n.Left
+				}
+				return n.Right
+			}
+
+		case "..":
+			// Only ranges small enough to be created by compiler are folded.
+			min, ok1 := n.Left.(*ast.IntegerNode)
+			max, ok2 := n.Right.(*ast.IntegerNode)
+			if ok1 && ok2 && (max.Value < min.Value || uint(max.Value-min.Value) < maxConstantRange) {
+				return fold(n)
+			}
+			return n
+		}
+
+		if isConstant(n.Left) && isConstant(n.Right) {
+			return fold(n)
+		}
+
+	case *ast.MatchesNode:
+		n.Left = optimize(n.Left)
+		n.Right = optimize(n.Right)
+
+		if s, ok := n.Right.(*ast.StringNode); ok && n.Regexp == nil {
+			if r, err := regexp.Compile(s.Value); err == nil {
+				n.Regexp = r
+			}
+		}
+		if isConstant(n.Left) && isConstant(n.Right) {
+			return fold(n)
+		}
+
+	case *ast.PropertyNode:
+		n.Node = optimize(n.Node)
+
+	case *ast.IndexNode:
+		n.Node = optimize(n.Node)
+		n.Index = optimize(n.Index)
+		if isConstant(n.Node) && isConstant(n.Index) {
+			return fold(n)
+		}
+
+	case *ast.MethodNode:
+		n.Node = optimize(n.Node)
+		optimizeAll(n.Arguments)
+
+	case *ast.FunctionNode:
+		optimizeAll(n.Arguments)
+
+	case *ast.BuiltinNode:
+		optimizeAll(n.Arguments)
+		if n.Name == "len" && isConstant(n.Arguments[0]) {
+			return fold(n)
+		}
+
+	case *ast.ClosureNode:
+		n.Node = optimize(n.Node)
+
+	case *ast.ConditionalNode:
+		n.Cond = optimize(n.Cond)
+		n.Exp1 = optimize(n.Exp1)
+		n.Exp2 = optimize(n.Exp2)
+		if b, ok := n.Cond.(*ast.BoolNode); ok {
+			if b.Value {
+				return n.Exp1
+			}
+			return n.Exp2
+		}
+
+	case *ast.ArrayNode:
+		if optimizeAll(n.Nodes) {
+			return fold(n)
+		}
+
+	case *ast.LetNode:
+		n.Value = optimize(n.Value)
+		n.Node = optimize(n.Node)
+
+	case *ast.MapNode:
+		constant := true
+		for _, pair := range n.Pairs {
+			pair.Value = optimize(pair.Value)
+			constant = constant && isConstant(pair.Key) && isConstant(pair.Value)
+		}
+		if constant {
+			return fold(n)
+		}
+	}
+	return node
+}
+
+// optimizeAll optimizes nodes in place and reports if all of them are constant.
+func optimizeAll(nodes []ast.Node) bool {
+	constant := true
+	for i := range nodes {
+		nodes[i] = optimize(nodes[i])
+		constant = constant && isConstant(nodes[i])
+	}
+	return constant
+}
+
+func isConstant(node ast.Node) bool {
+	switch node.(type) {
+	case *ast.IntegerNode, *ast.FloatNode, *ast.DurationNode, *ast.BoolNode, *ast.StringNode, *ast.ConstantNode:
+		return true
+	}
+	return false
+}
+
+func fold(node ast.Node) ast.Node {
+	value, ok := eval(node)
+	if !ok {
+		return node
+	}
+
+	var folded ast.Node
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Bool:
+		folded = &ast.BoolNode{Value: v.Bool()}
+	case reflect.String:
+		folded = &ast.StringNode{Value: v.String()}
+	case reflect.Float64:
+		folded = &ast.FloatNode{Value: v.Float()}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if d, ok := value.(time.Duration); ok {
+			folded = &ast.DurationNode{Value: d}
+		} else {
+			folded = &ast.IntegerNode{Value: int(v.Int()), Certain: true}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			folded = &ast.ConstantNode{Value: value}
+		} else {
+			folded = &ast.IntegerNode{Value: int(v.Uint()), Certain: true}
+		}
+	default:
+		folded = &ast.ConstantNode{Value: value}
+	}
+
+	folded.SetType(reflect.TypeOf(value))
+	folded.SetLocation(node.GetLocation())
+	return folded
+}
+
+func eval(node ast.Node) (value interface{}, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	c := &compiler{
+		index: make(map[interface{}]uint16),
+	}
+	c.compile(node)
+
+	program := &Program{
+		Locations: c.locations,
+		Constants: c.constants,
+		Bytecode:  c.bytecode,
+	}
+
+	value, err := Run(program, nil, nil)
+	return value, err == nil
+}
@@ -11,13 +11,20 @@ import (
 )
 
 // Eval parses, compiles and runs given input.
+// Funcs of the env shadow builtins with the same name.
 func Eval(input string, env interface{}, ctx context.Context) (interface{}, error) {
 	node, err := parser.Parse(input)
 	if err != nil {
 		return nil, err
 	}
 
-	program, err := compiler.Compile(node)
+	var ops []compiler.OptionFn
+	if env != nil {
+		// Funcs of the env shadow builtins with the same name.
+		ops = append(ops, compiler.Funcs(checker.CreateTypesTable(env).Func))
+	}
+
+	program, err := compiler.Compile(node, ops...)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +38,9 @@ func Eval(input string, env interface{}, ctx context.Context) (interface{}, erro
 }
 
 type config struct {
-	mapEnv bool
-	types  checker.TypesTable
+	mapEnv     bool
+	types      checker.TypesTable
+	noOptimize bool
 }
 
 // OptionFn for configuring expr.
@@ -52,6 +60,14 @@ func Env(i interface{}) OptionFn {
 	}
 }
 
+// Optimize enables or disables folding of constant expressions
+// on compile time, e.g. `2 ** 8` or `true ? a : b`. Enabled by default.
+func Optimize(enabled bool) OptionFn {
+	return func(c *config) {
+		c.noOptimize = !enabled
+	}
+}
+
 // CompileType compiles input and returns program with output type, if Env was specified.
 func CompileType(input string, ops ...OptionFn) (*vm.Program, reflect.Type, error) {
 	c := &config{}
@@ -77,6 +93,9 @@ func CompileType(input string, ops ...OptionFn) (*vm.Program, reflect.Type, erro
 	if c.mapEnv {
 		compilerOps = append(compilerOps, compiler.MapEnv())
 	}
+	if c.noOptimize {
+		compilerOps = append(compilerOps, compiler.Optimize(false))
+	}
 
 	program, err := compiler.Compile(node, compilerOps...)
 	if err != nil {
@@ -93,6 +112,12 @@ func Compile(input string, ops ...OptionFn) (*vm.Program, error) {
 }
 
 // Run evaluates given bytecode program.
-func Run(program *vm.Program, env interface{}, ctx context.Context) (interface{}, error) {
-	return vm.Run(program, env, ctx)
+// If ctx is canceled or its deadline exceeds, evaluation is stopped
+// and *vm.ContextError is returned.
+//
+// Execution budget may be limited with options (vm.MaxInstructions, vm.MaxStackSize,
+// vm.MaxRangeSize, vm.MaxAllocations and vm.MaxStringSize), on exceeding it
+// *vm.BudgetExceeded is returned.
+func Run(program *vm.Program, env interface{}, ctx context.Context, ops ...vm.RunOption) (interface{}, error) {
+	return vm.Run(program, env, ctx, ops...)
 }
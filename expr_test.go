@@ -3,6 +3,7 @@ package expr_test
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/jakub-gawlas/expr"
@@ -70,6 +71,79 @@ func ExampleEval_map_method() {
 	// Output: hello user
 }
 
+func ExampleEval_coalesce() {
+	env := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name": "Arthur",
+		},
+	}
+
+	output, err := expr.Eval("user.nickname ?? user.name", env, nil)
+	if err != nil {
+		fmt.Printf("%v", err)
+		return
+	}
+
+	fmt.Printf("%v", output)
+
+	// Output: Arthur
+}
+
+func ExampleEval_closure() {
+	type Item struct {
+		Sku string
+	}
+	type Order struct {
+		PrimarySku string
+		Items      []Item
+	}
+
+	env := map[string]interface{}{
+		"Orders": []Order{
+			{PrimarySku: "a", Items: []Item{{"a"}, {"b"}, {"a"}}},
+			{PrimarySku: "c", Items: []Item{{"b"}}},
+		},
+	}
+
+	output, err := expr.Eval("map(Orders, o => len(filter(o.Items, i => i.Sku == o.PrimarySku)))", env, nil)
+	if err != nil {
+		fmt.Printf("%v", err)
+		return
+	}
+
+	fmt.Printf("%v", output)
+
+	// Output: [2 0]
+}
+
+func ExampleEval_func() {
+	type Item struct {
+		Name  string
+		Price int
+	}
+
+	env := map[string]interface{}{
+		"Items": []Item{{"b", 20}, {"a", 10}, {"c", 30}},
+		"SortBy": func(items []Item, key func(Item) interface{}) []Item {
+			sorted := append([]Item{}, items...)
+			sort.SliceStable(sorted, func(i, j int) bool {
+				return key(sorted[i]).(int) < key(sorted[j]).(int)
+			})
+			return sorted
+		},
+	}
+
+	output, err := expr.Eval("map(SortBy(Items, {-.Price}), {.Name})", env, nil)
+	if err != nil {
+		fmt.Printf("%v", err)
+		return
+	}
+
+	fmt.Printf("%v", output)
+
+	// Output: [c b a]
+}
+
 func ExampleEval_struct() {
 	type C struct{ C int }
 	type B struct{ B *C }
@@ -1,4 +1,4 @@
-package helper
+package file
 
 import (
 	"fmt"
@@ -12,6 +12,9 @@ import (
 type Error struct {
 	Location Location
 	Message  string
+	// Snippet is a line of source with the error location marked,
+	// filled by Bind.
+	Snippet string
 }
 
 const (
@@ -24,16 +27,12 @@ var (
 	wideInd = width.Widen.String(ind)
 )
 
-func (e *Error) Format(source *Source) string {
+// Bind fills snippet of error from given source.
+func (e *Error) Bind(source *Source) *Error {
+	e.Snippet = ""
 	if e.Location.Empty() {
-		return e.Message
+		return e
 	}
-	var result = fmt.Sprintf(
-		"%s (%d:%d)",
-		e.Message,
-		e.Location.Line(),
-		e.Location.Column()+1, // add one to the 0-based column for display
-	)
 	if snippet, found := source.Snippet(e.Location.Line()); found {
 		snippet := strings.Replace(snippet, "\t", " ", -1)
 		srcLine := "\n | " + snippet
@@ -53,7 +52,25 @@ func (e *Error) Format(source *Source) string {
 		} else {
 			indLine += ind
 		}
-		result += srcLine + indLine
+		e.Snippet = srcLine + indLine
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Location.Empty() {
+		return e.Message
 	}
-	return result
+	return fmt.Sprintf(
+		"%s (%d:%d)%s",
+		e.Message,
+		e.Location.Line(),
+		e.Location.Column()+1, // add one to the 0-based column for display
+		e.Snippet,
+	)
+}
+
+// Format returns error message with location and snippet of the source.
+func (e *Error) Format(source *Source) string {
+	return e.Bind(source).Error()
 }
@@ -1,4 +1,4 @@
-package helper
+package file
 
 import (
 	"fmt"
@@ -24,16 +24,28 @@ func (e *Errors) ReportError(l Location, format string, args ...interface{}) {
 	e.errors = append(e.errors, err)
 }
 
+// GetErrors returns all reported errors bound to the source.
 func (e *Errors) GetErrors() []Error {
-	return e.errors[:]
+	errors := make([]Error, len(e.errors))
+	for i := range e.errors {
+		errors[i] = *e.errors[i].Bind(e.source)
+	}
+	return errors
 }
 
 func (e *Errors) HasError() bool {
 	return len(e.errors) > 0
 }
 
-func (e *Errors) First() string {
-	return e.errors[0].Format(e.source)
+// First returns the first reported error bound to the source.
+func (e *Errors) First() *Error {
+	return e.errors[0].Bind(e.source)
+}
+
+// Unwrap returns the first reported error, so errors.As can extract it.
+// All errors are available with GetErrors.
+func (e *Errors) Unwrap() error {
+	return e.First()
 }
 
 func (e *Errors) Error() string {
@@ -0,0 +1,55 @@
+// Package file describes expression source and positions within it,
+// so bytecode and errors can be mapped back to the source text.
+package file
+
+// Location is a span of the source from line:column to endLine:endColumn.
+type Location struct {
+	line   int
+	column int
+
+	endLine   int
+	endColumn int
+}
+
+func NewLocation(line, column int) Location {
+	return Location{
+		line:      line,
+		column:    column,
+		endLine:   line,
+		endColumn: column,
+	}
+}
+
+// NewRange returns location spanning from line:column to endLine:endColumn.
+func NewRange(line, column, endLine, endColumn int) Location {
+	return Location{
+		line:      line,
+		column:    column,
+		endLine:   endLine,
+		endColumn: endColumn,
+	}
+}
+
+// Line returns the 1-based line of the location.
+func (l Location) Line() int {
+	return l.line
+}
+
+// Column returns the 0-based column number of the location.
+func (l Location) Column() int {
+	return l.column
+}
+
+// EndLine returns the 1-based line where the location ends.
+func (l Location) EndLine() int {
+	return l.endLine
+}
+
+// EndColumn returns the 0-based column right after the end of the location.
+func (l Location) EndColumn() int {
+	return l.endColumn
+}
+
+func (l Location) Empty() bool {
+	return l.column == 0 && l.line == 0
+}
@@ -1,10 +1,11 @@
-package helper
+package file
 
 import (
 	"strings"
 	"unicode/utf8"
 )
 
+// Source is an expression text which locations refer to.
 type Source struct {
 	contents    []rune
 	lineOffsets []int32
@@ -29,7 +30,31 @@ func (s *Source) Content() string {
 	return string(s.contents)
 }
 
+// Offsets returns character offsets of the beginning and the end of the location
+// within the source, or false if location is outside of the source.
+func (s *Source) Offsets(l Location) (int, int, bool) {
+	if s == nil || l.Empty() {
+		return 0, 0, false
+	}
+	start, found := s.findLineOffset(l.Line())
+	if !found {
+		return 0, 0, false
+	}
+	end, found := s.findLineOffset(l.EndLine())
+	if !found {
+		return 0, 0, false
+	}
+	from, to := int(start)+l.Column(), int(end)+l.EndColumn()
+	if from > len(s.contents) || to > len(s.contents) || from > to {
+		return 0, 0, false
+	}
+	return from, to, true
+}
+
 func (s *Source) Snippet(line int) (string, bool) {
+	if s == nil {
+		return "", false
+	}
 	charStart, found := s.findLineOffset(line)
 	if !found || len(s.contents) == 0 {
 		return "", false
@@ -1,4 +1,4 @@
-package helper
+package file
 
 import (
 	"testing"
@@ -51,8 +51,34 @@ func TestStringSource_SnippetSingleline(t *testing.T) {
 		t.Errorf(unexpectedSnippet, t.Name(), str, "hello, world")
 	}
 	if str2, found := source.Snippet(2); found {
-		t.Error(snippetFound, t.Name(), 2)
+		t.Errorf(snippetFound, t.Name(), 2)
 	} else if str2 != "" {
-		t.Error(unexpectedSnippet, t.Name(), str2, "")
+		t.Errorf(unexpectedSnippet, t.Name(), str2, "")
+	}
+}
+
+// TestStringSource_Offsets of locations spanning one and several lines.
+func TestStringSource_Offsets(t *testing.T) {
+	source := NewSource("héllo\nworld")
+	content := []rune(source.Content())
+
+	tests := []struct {
+		location Location
+		want     string
+	}{
+		{NewRange(1, 1, 1, 5), "éllo"},
+		{NewRange(1, 3, 2, 2), "lo\nwo"},
+		{NewRange(2, 0, 2, 5), "world"},
+	}
+	for _, test := range tests {
+		start, end, found := source.Offsets(test.location)
+		if !found {
+			t.Errorf("%s offsets of %v not found", t.Name(), test.location)
+		} else if got := string(content[start:end]); got != test.want {
+			t.Errorf(unexpectedValue, t.Name(), got, test.want)
+		}
+	}
+	if _, _, found := source.Offsets(NewRange(3, 0, 3, 1)); found {
+		t.Errorf("%s offsets found at line 3, wanted none", t.Name())
 	}
 }
@@ -5,49 +5,47 @@ start
     ;
 
 expr
-    : '.' name=Identifier                       # ClosureMemberDotExpression
-    | expr '[' index=expr ']'                   # MemberIndexExpression
-    | expr '.' name=Identifier                  # MemberDotExpression
-    | builtins                                  # BuiltinLiteralExpression
-    | expr '(' args=arguments? ')'              # CallExpression
-    | op=( '+' | '-' | Not ) expr               # UnaryExpression
-    | expr op='..' expr                         # RangeExpression
-    | expr op=( '*' | '**' | '/' | '%' ) expr   # MultiplicativeExpression
-    | expr op=( '+' | '-' ) expr                # AdditiveExpression
-    | expr op=( '<' | '>' | '<=' | '>=' ) expr  # RelationalExpression
-    | expr op=StartsWith expr                   # StartsWithExpression
-    | expr op=EndsWith expr                     # EndsWithExpression
-    | expr op=Contains expr                     # ContainsExpression
-    | expr op=Matches pattern=expr              # MatchesExpression
-    | expr op=( In | NotIn ) expr               # InExpression
-    | expr op=( '==' | '!=' ) expr              # EqualityExpression
-    | expr op=And expr                          # LogicalExpression
-    | expr op=Or expr                           # LogicalExpression
-    | expr '?' e1=expr ':' e2=expr              # TernaryExpression
-    | Identifier                                # IdentifierExpression
-    | Pointer                                   # PointerExpression
-    | literal                                   # LiteralExpression
-    | arrayLiteral                              # ArrayLiteralExpression
-    | mapLiteral                                # MapLiteralExpression
-    | '(' expr ')'                              # ParenthesizedExpression
+    : '.' name=Identifier                                                # ClosureMemberDotExpression
+    | expr '[' index=expr ']'                                            # MemberIndexExpression
+    | expr op=( '.' | '?.' ) name=Identifier                             # MemberDotExpression
+    | expr '(' args=arguments? ')'                                       # CallExpression
+    | op=( '+' | '-' | Not | '~' ) expr                                  # UnaryExpression
+    | expr op='..' expr                                                  # RangeExpression
+    | expr op=( '*' | '**' | '/' | '%' | '&' | '&^' | '<<' | '>>' ) expr # MultiplicativeExpression
+    | expr op=( '+' | '-' | '|' | '^' ) expr                             # AdditiveExpression
+    | expr op=( '<' | '>' | '<=' | '>=' ) expr                           # RelationalExpression
+    | expr op=StartsWith expr                                            # StartsWithExpression
+    | expr op=EndsWith expr                                              # EndsWithExpression
+    | expr op=Contains expr                                              # ContainsExpression
+    | expr op=Matches pattern=expr                                       # MatchesExpression
+    | expr op=( In | NotIn ) expr                                        # InExpression
+    | expr op=( '==' | '!=' ) expr                                       # EqualityExpression
+    | expr op=And expr                                                   # LogicalExpression
+    | expr op=Or expr                                                    # LogicalExpression
+    | expr op='??' expr                                                  # NilCoalescingExpression
+    | expr '?' e1=expr ':' e2=expr                                       # TernaryExpression
+    | Identifier                                                         # IdentifierExpression
+    | Pointer                                                            # PointerExpression
+    | literal                                                            # LiteralExpression
+    | arrayLiteral                                                       # ArrayLiteralExpression
+    | mapLiteral                                                         # MapLiteralExpression
+    | '(' expr ')'                                                       # ParenthesizedExpression
+    | Let name=Identifier '=' value=expr ';' body=expr                   # LetExpression
     ;
 
-builtins
-    : 'len' '(' e=expr ')'                        # LenBuiltinExpression
-    | name='all'    '(' e=expr ',' c=closure ')'  # BuiltinExpression
-    | name='none'   '(' e=expr ',' c=closure ')'  # BuiltinExpression
-    | name='any'    '(' e=expr ',' c=closure ')'  # BuiltinExpression
-    | name='one'    '(' e=expr ',' c=closure ')'  # BuiltinExpression
-    | name='filter' '(' e=expr ',' c=closure ')'  # BuiltinExpression
-    | name='map'    '(' e=expr ',' c=closure ')'  # BuiltinExpression
+arguments
+    : list+=argument ( ',' list+=argument )*
     ;
 
-closure
-    : '{' body=expr '}' # ClosureExpression
+argument
+    : closure
+    | expr
     ;
 
-arguments
-    : list+=expr ( ',' list+=expr )*
+closure
+    : '{' body=expr '}'                                                     # ClosureExpression
+    | params+=Identifier '=>' body=expr                                     # ClosureExpression
+    | '(' params+=Identifier ( ',' params+=Identifier )? ')' '=>' body=expr # ClosureExpression
     ;
 
 arrayLiteral
@@ -74,11 +72,12 @@ propertyName
     ;
 
 literal
-    : NilLiteral             # NilExpression
-    | BooleanLiteral         # BooleanExpression
-    | stringLiteral          # StringLiteralExpression
-    | integerLiteral         # IntegerExpression
-    | FloatLiteral           # FloatExpression
+    : NilLiteral      # NilExpression
+    | BooleanLiteral  # BooleanExpression
+    | stringLiteral   # StringLiteralExpression
+    | integerLiteral  # IntegerExpression
+    | FloatLiteral    # FloatExpression
+    | DurationLiteral # DurationExpression
     ;
 
 stringLiteral
@@ -88,6 +87,8 @@ stringLiteral
 integerLiteral
     : IntegerLiteral
     | HexIntegerLiteral
+    | OctalIntegerLiteral
+    | BinaryIntegerLiteral
     ;
 
 /*****************************/
@@ -105,16 +106,26 @@ CloseBrace                 : '}';
 SemiColon                  : ';';
 Comma                      : ',';
 Assign                     : '=';
+Arrow                      : '=>';
 QuestionMark               : '?';
+// Ternary operator followed by a float literal, like a?.5:1, is not a nil-safe accessor.
+QuestionDot                : '?.' {p.GetInputStream().LA(1) < '0' || p.GetInputStream().LA(1) > '9'}?;
+NilCoalescing              : '??';
 Colon                      : ':';
 Dot                        : '.';
+Range                      : '..';
 Plus                       : '+';
 Minus                      : '-';
 Not                        : ( '!' | 'not' );
+BitNot                     : '~';
 Multiply                   : '*';
 Exponent                   : '**';
 Divide                     : '/';
 Modulus                    : '%';
+BitAnd                     : '&';
+BitOr                      : '|';
+BitXor                     : '^';
+BitClear                   : '&^';
 RightShiftArithmetic       : '>>';
 LeftShiftArithmetic        : '<<';
 LessThan                   : '<';
@@ -123,16 +134,16 @@ LessThanEquals             : '<=';
 GreaterThanEquals          : '>=';
 Equals                     : '==';
 NotEquals                  : '!=';
-Pointer                    : '#';
+Pointer                    : '#' IdentifierName?;
 And                        : ( '&&' | 'and' );
 Or                         : ( '||' | 'or' );
-Builtins                   : ( 'all' | 'none' | 'any' | 'one' | 'filter' | 'map' );
 StartsWith                 : 'startsWith';
 EndsWith                   : 'endsWith';
 Contains                   : 'contains';
 Matches                    : 'matches';
 In                         : 'in';
 NotIn                      : 'not in';
+Let                        : 'let';
 
 NilLiteral
     : 'nil'
@@ -143,27 +154,44 @@ BooleanLiteral
     | 'false'
     ;
 
+// Number literals are matched greedily, including misplaced separators and
+// invalid digits, to be reported by the parser.
 IntegerLiteral
-    : '0'
-    | [1-9] [0-9_]*
+    : DecimalDigit DecimalDigitOrSeparator*
     ;
 
 FloatLiteral
-    : DecimalLiteral '.' DecimalDigit+
-    | '.' DecimalDigit+
+    : DecimalDigit DecimalDigitOrSeparator* '.' DecimalDigit DecimalDigitOrSeparator* ExponentPart?
+    | '.' DecimalDigit DecimalDigitOrSeparator* ExponentPart?
+    | DecimalDigit DecimalDigitOrSeparator* ExponentPart
     ;
 
 HexIntegerLiteral
-    : '0' [xX] HexDigit+
+    : '0' [xX] [0-9a-fA-F_]*
+    ;
+
+OctalIntegerLiteral
+    : '0' [oO] DecimalDigitOrSeparator*
+    ;
+
+BinaryIntegerLiteral
+    : '0' [bB] DecimalDigitOrSeparator*
+    ;
+
+// Duration followed by other identifier characters, like 3min, is matched
+// as a whole to be reported as invalid by the parser.
+DurationLiteral
+    : ( DecimalDigit+ ( '.' DecimalDigit+ )? DurationUnit )+ IdentifierPart*
     ;
 
 Identifier
-    : IdentifierStart IdentifierPart*
+    : IdentifierName
     ;
 
 StringLiteral
     : '"' DoubleStringCharacter* '"'
     | '\'' SingleStringCharacter* '\''
+    | '`' ~'`'* '`'
     ;
 
 WhiteSpaces
@@ -186,60 +214,41 @@ UnexpectedCharacter
     : .
     ;
 
+// Escape sequences are validated by the parser.
 fragment DoubleStringCharacter
     : ~["\\\r\n]
-    | '\\' EscapeSequence
-    | LineContinuation
+    | '\\' ( '\r\n' | . )
     ;
 fragment SingleStringCharacter
     : ~['\\\r\n]
-    | '\\' EscapeSequence
-    | LineContinuation
-    ;
-fragment EscapeSequence
-    : CharacterEscapeSequence
-    | '0' // no digit ahead! TODO
-    | HexEscapeSequence
-    | UnicodeEscapeSequence
-    ;
-fragment CharacterEscapeSequence
-    : SingleEscapeCharacter
-    | NonEscapeCharacter
-    ;
-fragment HexEscapeSequence
-    : 'x' HexDigit HexDigit
+    | '\\' ( '\r\n' | . )
     ;
 fragment UnicodeEscapeSequence
     : 'u' HexDigit HexDigit HexDigit HexDigit
     ;
-fragment SingleEscapeCharacter
-    : ['"\\bfnrtv]
-    ;
-
-fragment NonEscapeCharacter
-    : ~['"\\bfnrtv0-9xu\r\n]
-    ;
-fragment EscapeCharacter
-    : SingleEscapeCharacter
-    | DecimalDigit
-    | [xu]
-    ;
-fragment LineContinuation
-    : '\\' LineTerminatorSequence
-    ;
-fragment LineTerminatorSequence
-    : '\r\n'
-    | LineTerminator
-    ;
 fragment DecimalDigit
     : [0-9]
     ;
+fragment DecimalDigitOrSeparator
+    : [0-9_]
+    ;
 fragment HexDigit
     : [0-9a-fA-F]
     ;
-fragment DecimalLiteral
-    : '0'
-    | [1-9] DecimalDigit*
+fragment ExponentPart
+    : [eE] [+-]? DecimalDigit DecimalDigitOrSeparator*
+    ;
+fragment DurationUnit
+    : 'ns'
+    | 'us'
+    | 'µs'
+    | 'ms'
+    | 's'
+    | 'm'
+    | 'h'
+    ;
+fragment IdentifierName
+    : IdentifierStart IdentifierPart*
     ;
 fragment IdentifierStart
     : UnicodeLetter
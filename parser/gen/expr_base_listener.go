@@ -26,6 +26,78 @@ func (s *BaseExprListener) EnterStart(ctx *StartContext) {}
 // ExitStart is called when production start is exited.
 func (s *BaseExprListener) ExitStart(ctx *StartContext) {}
 
+// EnterTernaryExpression is called when production TernaryExpression is entered.
+func (s *BaseExprListener) EnterTernaryExpression(ctx *TernaryExpressionContext) {}
+
+// ExitTernaryExpression is called when production TernaryExpression is exited.
+func (s *BaseExprListener) ExitTernaryExpression(ctx *TernaryExpressionContext) {}
+
+// EnterNilCoalescingExpression is called when production NilCoalescingExpression is entered.
+func (s *BaseExprListener) EnterNilCoalescingExpression(ctx *NilCoalescingExpressionContext) {}
+
+// ExitNilCoalescingExpression is called when production NilCoalescingExpression is exited.
+func (s *BaseExprListener) ExitNilCoalescingExpression(ctx *NilCoalescingExpressionContext) {}
+
+// EnterInExpression is called when production InExpression is entered.
+func (s *BaseExprListener) EnterInExpression(ctx *InExpressionContext) {}
+
+// ExitInExpression is called when production InExpression is exited.
+func (s *BaseExprListener) ExitInExpression(ctx *InExpressionContext) {}
+
+// EnterUnaryExpression is called when production UnaryExpression is entered.
+func (s *BaseExprListener) EnterUnaryExpression(ctx *UnaryExpressionContext) {}
+
+// ExitUnaryExpression is called when production UnaryExpression is exited.
+func (s *BaseExprListener) ExitUnaryExpression(ctx *UnaryExpressionContext) {}
+
+// EnterRangeExpression is called when production RangeExpression is entered.
+func (s *BaseExprListener) EnterRangeExpression(ctx *RangeExpressionContext) {}
+
+// ExitRangeExpression is called when production RangeExpression is exited.
+func (s *BaseExprListener) ExitRangeExpression(ctx *RangeExpressionContext) {}
+
+// EnterLogicalExpression is called when production LogicalExpression is entered.
+func (s *BaseExprListener) EnterLogicalExpression(ctx *LogicalExpressionContext) {}
+
+// ExitLogicalExpression is called when production LogicalExpression is exited.
+func (s *BaseExprListener) ExitLogicalExpression(ctx *LogicalExpressionContext) {}
+
+// EnterLetExpression is called when production LetExpression is entered.
+func (s *BaseExprListener) EnterLetExpression(ctx *LetExpressionContext) {}
+
+// ExitLetExpression is called when production LetExpression is exited.
+func (s *BaseExprListener) ExitLetExpression(ctx *LetExpressionContext) {}
+
+// EnterEndsWithExpression is called when production EndsWithExpression is entered.
+func (s *BaseExprListener) EnterEndsWithExpression(ctx *EndsWithExpressionContext) {}
+
+// ExitEndsWithExpression is called when production EndsWithExpression is exited.
+func (s *BaseExprListener) ExitEndsWithExpression(ctx *EndsWithExpressionContext) {}
+
+// EnterStartsWithExpression is called when production StartsWithExpression is entered.
+func (s *BaseExprListener) EnterStartsWithExpression(ctx *StartsWithExpressionContext) {}
+
+// ExitStartsWithExpression is called when production StartsWithExpression is exited.
+func (s *BaseExprListener) ExitStartsWithExpression(ctx *StartsWithExpressionContext) {}
+
+// EnterEqualityExpression is called when production EqualityExpression is entered.
+func (s *BaseExprListener) EnterEqualityExpression(ctx *EqualityExpressionContext) {}
+
+// ExitEqualityExpression is called when production EqualityExpression is exited.
+func (s *BaseExprListener) ExitEqualityExpression(ctx *EqualityExpressionContext) {}
+
+// EnterMultiplicativeExpression is called when production MultiplicativeExpression is entered.
+func (s *BaseExprListener) EnterMultiplicativeExpression(ctx *MultiplicativeExpressionContext) {}
+
+// ExitMultiplicativeExpression is called when production MultiplicativeExpression is exited.
+func (s *BaseExprListener) ExitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) {}
+
+// EnterCallExpression is called when production CallExpression is entered.
+func (s *BaseExprListener) EnterCallExpression(ctx *CallExpressionContext) {}
+
+// ExitCallExpression is called when production CallExpression is exited.
+func (s *BaseExprListener) ExitCallExpression(ctx *CallExpressionContext) {}
+
 // EnterParenthesizedExpression is called when production ParenthesizedExpression is entered.
 func (s *BaseExprListener) EnterParenthesizedExpression(ctx *ParenthesizedExpressionContext) {}
 
@@ -44,12 +116,6 @@ func (s *BaseExprListener) EnterRelationalExpression(ctx *RelationalExpressionCo
 // ExitRelationalExpression is called when production RelationalExpression is exited.
 func (s *BaseExprListener) ExitRelationalExpression(ctx *RelationalExpressionContext) {}
 
-// EnterTernaryExpression is called when production TernaryExpression is entered.
-func (s *BaseExprListener) EnterTernaryExpression(ctx *TernaryExpressionContext) {}
-
-// ExitTernaryExpression is called when production TernaryExpression is exited.
-func (s *BaseExprListener) ExitTernaryExpression(ctx *TernaryExpressionContext) {}
-
 // EnterContainsExpression is called when production ContainsExpression is entered.
 func (s *BaseExprListener) EnterContainsExpression(ctx *ContainsExpressionContext) {}
 
@@ -74,12 +140,6 @@ func (s *BaseExprListener) EnterLiteralExpression(ctx *LiteralExpressionContext)
 // ExitLiteralExpression is called when production LiteralExpression is exited.
 func (s *BaseExprListener) ExitLiteralExpression(ctx *LiteralExpressionContext) {}
 
-// EnterInExpression is called when production InExpression is entered.
-func (s *BaseExprListener) EnterInExpression(ctx *InExpressionContext) {}
-
-// ExitInExpression is called when production InExpression is exited.
-func (s *BaseExprListener) ExitInExpression(ctx *InExpressionContext) {}
-
 // EnterArrayLiteralExpression is called when production ArrayLiteralExpression is entered.
 func (s *BaseExprListener) EnterArrayLiteralExpression(ctx *ArrayLiteralExpressionContext) {}
 
@@ -92,18 +152,6 @@ func (s *BaseExprListener) EnterMemberDotExpression(ctx *MemberDotExpressionCont
 // ExitMemberDotExpression is called when production MemberDotExpression is exited.
 func (s *BaseExprListener) ExitMemberDotExpression(ctx *MemberDotExpressionContext) {}
 
-// EnterUnaryExpression is called when production UnaryExpression is entered.
-func (s *BaseExprListener) EnterUnaryExpression(ctx *UnaryExpressionContext) {}
-
-// ExitUnaryExpression is called when production UnaryExpression is exited.
-func (s *BaseExprListener) ExitUnaryExpression(ctx *UnaryExpressionContext) {}
-
-// EnterRangeExpression is called when production RangeExpression is entered.
-func (s *BaseExprListener) EnterRangeExpression(ctx *RangeExpressionContext) {}
-
-// ExitRangeExpression is called when production RangeExpression is exited.
-func (s *BaseExprListener) ExitRangeExpression(ctx *RangeExpressionContext) {}
-
 // EnterMemberIndexExpression is called when production MemberIndexExpression is entered.
 func (s *BaseExprListener) EnterMemberIndexExpression(ctx *MemberIndexExpressionContext) {}
 
@@ -122,65 +170,23 @@ func (s *BaseExprListener) EnterPointerExpression(ctx *PointerExpressionContext)
 // ExitPointerExpression is called when production PointerExpression is exited.
 func (s *BaseExprListener) ExitPointerExpression(ctx *PointerExpressionContext) {}
 
-// EnterLogicalExpression is called when production LogicalExpression is entered.
-func (s *BaseExprListener) EnterLogicalExpression(ctx *LogicalExpressionContext) {}
-
-// ExitLogicalExpression is called when production LogicalExpression is exited.
-func (s *BaseExprListener) ExitLogicalExpression(ctx *LogicalExpressionContext) {}
-
 // EnterClosureMemberDotExpression is called when production ClosureMemberDotExpression is entered.
 func (s *BaseExprListener) EnterClosureMemberDotExpression(ctx *ClosureMemberDotExpressionContext) {}
 
 // ExitClosureMemberDotExpression is called when production ClosureMemberDotExpression is exited.
 func (s *BaseExprListener) ExitClosureMemberDotExpression(ctx *ClosureMemberDotExpressionContext) {}
 
-// EnterEndsWithExpression is called when production EndsWithExpression is entered.
-func (s *BaseExprListener) EnterEndsWithExpression(ctx *EndsWithExpressionContext) {}
-
-// ExitEndsWithExpression is called when production EndsWithExpression is exited.
-func (s *BaseExprListener) ExitEndsWithExpression(ctx *EndsWithExpressionContext) {}
-
-// EnterStartsWithExpression is called when production StartsWithExpression is entered.
-func (s *BaseExprListener) EnterStartsWithExpression(ctx *StartsWithExpressionContext) {}
-
-// ExitStartsWithExpression is called when production StartsWithExpression is exited.
-func (s *BaseExprListener) ExitStartsWithExpression(ctx *StartsWithExpressionContext) {}
-
-// EnterEqualityExpression is called when production EqualityExpression is entered.
-func (s *BaseExprListener) EnterEqualityExpression(ctx *EqualityExpressionContext) {}
-
-// ExitEqualityExpression is called when production EqualityExpression is exited.
-func (s *BaseExprListener) ExitEqualityExpression(ctx *EqualityExpressionContext) {}
-
-// EnterBuiltinLiteralExpression is called when production BuiltinLiteralExpression is entered.
-func (s *BaseExprListener) EnterBuiltinLiteralExpression(ctx *BuiltinLiteralExpressionContext) {}
-
-// ExitBuiltinLiteralExpression is called when production BuiltinLiteralExpression is exited.
-func (s *BaseExprListener) ExitBuiltinLiteralExpression(ctx *BuiltinLiteralExpressionContext) {}
-
-// EnterMultiplicativeExpression is called when production MultiplicativeExpression is entered.
-func (s *BaseExprListener) EnterMultiplicativeExpression(ctx *MultiplicativeExpressionContext) {}
-
-// ExitMultiplicativeExpression is called when production MultiplicativeExpression is exited.
-func (s *BaseExprListener) ExitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) {}
-
-// EnterCallExpression is called when production CallExpression is entered.
-func (s *BaseExprListener) EnterCallExpression(ctx *CallExpressionContext) {}
-
-// ExitCallExpression is called when production CallExpression is exited.
-func (s *BaseExprListener) ExitCallExpression(ctx *CallExpressionContext) {}
-
-// EnterLenBuiltinExpression is called when production LenBuiltinExpression is entered.
-func (s *BaseExprListener) EnterLenBuiltinExpression(ctx *LenBuiltinExpressionContext) {}
+// EnterArguments is called when production arguments is entered.
+func (s *BaseExprListener) EnterArguments(ctx *ArgumentsContext) {}
 
-// ExitLenBuiltinExpression is called when production LenBuiltinExpression is exited.
-func (s *BaseExprListener) ExitLenBuiltinExpression(ctx *LenBuiltinExpressionContext) {}
+// ExitArguments is called when production arguments is exited.
+func (s *BaseExprListener) ExitArguments(ctx *ArgumentsContext) {}
 
-// EnterBuiltinExpression is called when production BuiltinExpression is entered.
-func (s *BaseExprListener) EnterBuiltinExpression(ctx *BuiltinExpressionContext) {}
+// EnterArgument is called when production argument is entered.
+func (s *BaseExprListener) EnterArgument(ctx *ArgumentContext) {}
 
-// ExitBuiltinExpression is called when production BuiltinExpression is exited.
-func (s *BaseExprListener) ExitBuiltinExpression(ctx *BuiltinExpressionContext) {}
+// ExitArgument is called when production argument is exited.
+func (s *BaseExprListener) ExitArgument(ctx *ArgumentContext) {}
 
 // EnterClosureExpression is called when production ClosureExpression is entered.
 func (s *BaseExprListener) EnterClosureExpression(ctx *ClosureExpressionContext) {}
@@ -188,12 +194,6 @@ func (s *BaseExprListener) EnterClosureExpression(ctx *ClosureExpressionContext)
 // ExitClosureExpression is called when production ClosureExpression is exited.
 func (s *BaseExprListener) ExitClosureExpression(ctx *ClosureExpressionContext) {}
 
-// EnterArguments is called when production arguments is entered.
-func (s *BaseExprListener) EnterArguments(ctx *ArgumentsContext) {}
-
-// ExitArguments is called when production arguments is exited.
-func (s *BaseExprListener) ExitArguments(ctx *ArgumentsContext) {}
-
 // EnterArrayLiteral is called when production arrayLiteral is entered.
 func (s *BaseExprListener) EnterArrayLiteral(ctx *ArrayLiteralContext) {}
 
@@ -254,6 +254,12 @@ func (s *BaseExprListener) EnterFloatExpression(ctx *FloatExpressionContext) {}
 // ExitFloatExpression is called when production FloatExpression is exited.
 func (s *BaseExprListener) ExitFloatExpression(ctx *FloatExpressionContext) {}
 
+// EnterDurationExpression is called when production DurationExpression is entered.
+func (s *BaseExprListener) EnterDurationExpression(ctx *DurationExpressionContext) {}
+
+// ExitDurationExpression is called when production DurationExpression is exited.
+func (s *BaseExprListener) ExitDurationExpression(ctx *DurationExpressionContext) {}
+
 // EnterStringLiteral is called when production stringLiteral is entered.
 func (s *BaseExprListener) EnterStringLiteral(ctx *StringLiteralContext) {}
 
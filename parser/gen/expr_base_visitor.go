@@ -11,115 +11,115 @@ func (v *BaseExprVisitor) VisitStart(ctx *StartContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitParenthesizedExpression(ctx *ParenthesizedExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitTernaryExpression(ctx *TernaryExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitNilCoalescingExpression(ctx *NilCoalescingExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitRelationalExpression(ctx *RelationalExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitInExpression(ctx *InExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitTernaryExpression(ctx *TernaryExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitUnaryExpression(ctx *UnaryExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitContainsExpression(ctx *ContainsExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitRangeExpression(ctx *RangeExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitMatchesExpression(ctx *MatchesExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitLogicalExpression(ctx *LogicalExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitMapLiteralExpression(ctx *MapLiteralExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitLetExpression(ctx *LetExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitLiteralExpression(ctx *LiteralExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitEndsWithExpression(ctx *EndsWithExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitInExpression(ctx *InExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitStartsWithExpression(ctx *StartsWithExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitArrayLiteralExpression(ctx *ArrayLiteralExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitEqualityExpression(ctx *EqualityExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitMemberDotExpression(ctx *MemberDotExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitUnaryExpression(ctx *UnaryExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitCallExpression(ctx *CallExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitRangeExpression(ctx *RangeExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitParenthesizedExpression(ctx *ParenthesizedExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitMemberIndexExpression(ctx *MemberIndexExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitIdentifierExpression(ctx *IdentifierExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitRelationalExpression(ctx *RelationalExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitPointerExpression(ctx *PointerExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitContainsExpression(ctx *ContainsExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitLogicalExpression(ctx *LogicalExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitMatchesExpression(ctx *MatchesExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitClosureMemberDotExpression(ctx *ClosureMemberDotExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitMapLiteralExpression(ctx *MapLiteralExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitEndsWithExpression(ctx *EndsWithExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitLiteralExpression(ctx *LiteralExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitStartsWithExpression(ctx *StartsWithExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitArrayLiteralExpression(ctx *ArrayLiteralExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitEqualityExpression(ctx *EqualityExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitMemberDotExpression(ctx *MemberDotExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitBuiltinLiteralExpression(ctx *BuiltinLiteralExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitMemberIndexExpression(ctx *MemberIndexExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitIdentifierExpression(ctx *IdentifierExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitCallExpression(ctx *CallExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitPointerExpression(ctx *PointerExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitLenBuiltinExpression(ctx *LenBuiltinExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitClosureMemberDotExpression(ctx *ClosureMemberDotExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitBuiltinExpression(ctx *BuiltinExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitArguments(ctx *ArgumentsContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitClosureExpression(ctx *ClosureExpressionContext) interface{} {
+func (v *BaseExprVisitor) VisitArgument(ctx *ArgumentContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseExprVisitor) VisitArguments(ctx *ArgumentsContext) interface{} {
+func (v *BaseExprVisitor) VisitClosureExpression(ctx *ClosureExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -163,6 +163,10 @@ func (v *BaseExprVisitor) VisitFloatExpression(ctx *FloatExpressionContext) inte
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseExprVisitor) VisitDurationExpression(ctx *DurationExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseExprVisitor) VisitStringLiteral(ctx *StringLiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
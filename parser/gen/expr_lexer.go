@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 59, 550,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 63, 583,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -29,305 +29,323 @@ var serializedLexerAtn = []uint16{
 	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
 	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
 	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
-	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 3,
-	2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3,
-	5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3,
-	8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15,
-	3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3,
-	21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24,
-	229, 10, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3,
-	28, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 32, 3, 32,
-	3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 36, 3,
-	36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 5, 38, 269,
-	10, 38, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 275, 10, 39, 3, 40, 3, 40, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40,
-	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 299,
-	10, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41,
-	3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3,
-	42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44,
-	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3,
-	46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47,
-	3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 5, 48, 361,
-	10, 48, 3, 49, 3, 49, 3, 49, 7, 49, 366, 10, 49, 12, 49, 14, 49, 369, 11,
-	49, 5, 49, 371, 10, 49, 3, 50, 3, 50, 3, 50, 6, 50, 376, 10, 50, 13, 50,
-	14, 50, 377, 3, 50, 3, 50, 6, 50, 382, 10, 50, 13, 50, 14, 50, 383, 5,
-	50, 386, 10, 50, 3, 51, 3, 51, 3, 51, 6, 51, 391, 10, 51, 13, 51, 14, 51,
-	392, 3, 52, 3, 52, 7, 52, 397, 10, 52, 12, 52, 14, 52, 400, 11, 52, 3,
-	53, 3, 53, 7, 53, 404, 10, 53, 12, 53, 14, 53, 407, 11, 53, 3, 53, 3, 53,
-	3, 53, 7, 53, 412, 10, 53, 12, 53, 14, 53, 415, 11, 53, 3, 53, 5, 53, 418,
-	10, 53, 3, 54, 6, 54, 421, 10, 54, 13, 54, 14, 54, 422, 3, 54, 3, 54, 3,
-	55, 3, 55, 3, 55, 3, 55, 7, 55, 431, 10, 55, 12, 55, 14, 55, 434, 11, 55,
-	3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 7, 56, 445,
-	10, 56, 12, 56, 14, 56, 448, 11, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57,
-	3, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 462, 10, 59, 3,
-	60, 3, 60, 3, 60, 3, 60, 5, 60, 468, 10, 60, 3, 61, 3, 61, 3, 61, 3, 61,
-	5, 61, 474, 10, 61, 3, 62, 3, 62, 5, 62, 478, 10, 62, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 66,
-	3, 66, 3, 67, 3, 67, 3, 67, 5, 67, 497, 10, 67, 3, 68, 3, 68, 3, 68, 3,
-	69, 3, 69, 3, 69, 5, 69, 505, 10, 69, 3, 70, 3, 70, 3, 71, 3, 71, 3, 72,
-	3, 72, 3, 72, 7, 72, 514, 10, 72, 12, 72, 14, 72, 517, 11, 72, 5, 72, 519,
-	10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 5, 73, 525, 10, 73, 3, 74, 3, 74, 3,
-	74, 3, 74, 3, 74, 3, 74, 5, 74, 533, 10, 74, 3, 75, 5, 75, 536, 10, 75,
-	3, 76, 5, 76, 539, 10, 76, 3, 77, 5, 77, 542, 10, 77, 3, 78, 5, 78, 545,
-	10, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 432, 2, 81, 3, 3, 5, 4, 7, 5, 9,
-	6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15,
-	29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24,
-	47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33,
-	65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42,
-	83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51,
-	101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59,
-	117, 2, 119, 2, 121, 2, 123, 2, 125, 2, 127, 2, 129, 2, 131, 2, 133, 2,
-	135, 2, 137, 2, 139, 2, 141, 2, 143, 2, 145, 2, 147, 2, 149, 2, 151, 2,
-	153, 2, 155, 2, 157, 2, 159, 2, 3, 2, 19, 3, 2, 51, 59, 4, 2, 50, 59, 97,
-	97, 4, 2, 90, 90, 122, 122, 6, 2, 11, 11, 13, 14, 34, 34, 162, 162, 5,
-	2, 12, 12, 15, 15, 8234, 8235, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94, 6,
-	2, 12, 12, 15, 15, 41, 41, 94, 94, 11, 2, 36, 36, 41, 41, 94, 94, 100,
-	100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120, 14, 2, 12, 12, 15,
-	15, 36, 36, 41, 41, 50, 59, 94, 94, 100, 100, 104, 104, 112, 112, 116,
-	116, 118, 120, 122, 122, 4, 2, 119, 119, 122, 122, 3, 2, 50, 59, 5, 2,
-	50, 59, 67, 72, 99, 104, 4, 2, 38, 38, 97, 97, 260, 2, 67, 92, 99, 124,
-	172, 172, 183, 183, 188, 188, 194, 216, 218, 248, 250, 545, 548, 565, 594,
-	687, 690, 698, 701, 707, 722, 723, 738, 742, 752, 752, 892, 892, 904, 904,
-	906, 908, 910, 910, 912, 931, 933, 976, 978, 985, 988, 1013, 1026, 1155,
-	1166, 1222, 1225, 1226, 1229, 1230, 1234, 1271, 1274, 1275, 1331, 1368,
-	1371, 1371, 1379, 1417, 1490, 1516, 1522, 1524, 1571, 1596, 1602, 1612,
-	1651, 1749, 1751, 1751, 1767, 1768, 1788, 1790, 1810, 1810, 1812, 1838,
-	1922, 1959, 2311, 2363, 2367, 2367, 2386, 2386, 2394, 2403, 2439, 2446,
-	2449, 2450, 2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2526, 2527,
-	2529, 2531, 2546, 2547, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610,
-	2612, 2613, 2615, 2616, 2618, 2619, 2651, 2654, 2656, 2656, 2676, 2678,
-	2695, 2701, 2703, 2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740, 2741,
-	2743, 2747, 2751, 2751, 2770, 2770, 2786, 2786, 2823, 2830, 2833, 2834,
-	2837, 2858, 2860, 2866, 2868, 2869, 2872, 2875, 2879, 2879, 2910, 2911,
-	2913, 2915, 2951, 2956, 2960, 2962, 2964, 2967, 2971, 2972, 2974, 2974,
-	2976, 2977, 2981, 2982, 2986, 2988, 2992, 2999, 3001, 3003, 3079, 3086,
-	3088, 3090, 3092, 3114, 3116, 3125, 3127, 3131, 3170, 3171, 3207, 3214,
-	3216, 3218, 3220, 3242, 3244, 3253, 3255, 3259, 3296, 3296, 3298, 3299,
-	3335, 3342, 3344, 3346, 3348, 3370, 3372, 3387, 3426, 3427, 3463, 3480,
-	3484, 3507, 3509, 3517, 3519, 3519, 3522, 3528, 3587, 3634, 3636, 3637,
-	3650, 3656, 3715, 3716, 3718, 3718, 3721, 3722, 3724, 3724, 3727, 3727,
-	3734, 3737, 3739, 3745, 3747, 3749, 3751, 3751, 3753, 3753, 3756, 3757,
-	3759, 3762, 3764, 3765, 3775, 3782, 3784, 3784, 3806, 3807, 3842, 3842,
-	3906, 3948, 3978, 3981, 4098, 4131, 4133, 4137, 4139, 4140, 4178, 4183,
-	4258, 4295, 4306, 4344, 4354, 4443, 4449, 4516, 4522, 4603, 4610, 4616,
-	4618, 4680, 4682, 4682, 4684, 4687, 4690, 4696, 4698, 4698, 4700, 4703,
-	4706, 4744, 4746, 4746, 4748, 4751, 4754, 4784, 4786, 4786, 4788, 4791,
-	4794, 4800, 4802, 4802, 4804, 4807, 4810, 4816, 4818, 4824, 4826, 4848,
-	4850, 4880, 4882, 4882, 4884, 4887, 4890, 4896, 4898, 4936, 4938, 4956,
-	5026, 5110, 5123, 5752, 5763, 5788, 5794, 5868, 6018, 6069, 6178, 6265,
-	6274, 6314, 7682, 7837, 7842, 7931, 7938, 7959, 7962, 7967, 7970, 8007,
-	8010, 8015, 8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033, 8063,
-	8066, 8118, 8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146, 8149,
-	8152, 8157, 8162, 8174, 8180, 8182, 8184, 8190, 8321, 8321, 8452, 8452,
-	8457, 8457, 8460, 8469, 8471, 8471, 8475, 8479, 8486, 8486, 8488, 8488,
-	8490, 8490, 8492, 8495, 8497, 8499, 8501, 8507, 8546, 8581, 12295, 12297,
-	12323, 12331, 12339, 12343, 12346, 12348, 12355, 12438, 12447, 12448, 12451,
-	12540, 12542, 12544, 12551, 12590, 12595, 12688, 12706, 12729, 13314, 13314,
-	19895, 19895, 19970, 19970, 40871, 40871, 40962, 42126, 44034, 44034, 55205,
-	55205, 63746, 64047, 64258, 64264, 64277, 64281, 64287, 64287, 64289, 64298,
-	64300, 64312, 64314, 64318, 64320, 64320, 64322, 64323, 64325, 64326, 64328,
-	64435, 64469, 64831, 64850, 64913, 64916, 64969, 65010, 65021, 65138, 65140,
-	65142, 65142, 65144, 65278, 65315, 65340, 65347, 65372, 65384, 65472, 65476,
-	65481, 65484, 65489, 65492, 65497, 65500, 65502, 102, 2, 770, 848, 866,
-	868, 1157, 1160, 1427, 1443, 1445, 1467, 1469, 1471, 1473, 1473, 1475,
-	1476, 1478, 1478, 1613, 1623, 1650, 1650, 1752, 1758, 1761, 1766, 1769,
-	1770, 1772, 1775, 1811, 1811, 1842, 1868, 1960, 1970, 2307, 2309, 2366,
-	2366, 2368, 2383, 2387, 2390, 2404, 2405, 2435, 2437, 2494, 2502, 2505,
-	2506, 2509, 2511, 2521, 2521, 2532, 2533, 2564, 2564, 2622, 2622, 2624,
-	2628, 2633, 2634, 2637, 2639, 2674, 2675, 2691, 2693, 2750, 2750, 2752,
-	2759, 2761, 2763, 2765, 2767, 2819, 2821, 2878, 2878, 2880, 2885, 2889,
-	2890, 2893, 2895, 2904, 2905, 2948, 2949, 3008, 3012, 3016, 3018, 3020,
-	3023, 3033, 3033, 3075, 3077, 3136, 3142, 3144, 3146, 3148, 3151, 3159,
-	3160, 3204, 3205, 3264, 3270, 3272, 3274, 3276, 3279, 3287, 3288, 3332,
-	3333, 3392, 3397, 3400, 3402, 3404, 3407, 3417, 3417, 3460, 3461, 3532,
-	3532, 3537, 3542, 3544, 3544, 3546, 3553, 3572, 3573, 3635, 3635, 3638,
-	3644, 3657, 3664, 3763, 3763, 3766, 3771, 3773, 3774, 3786, 3791, 3866,
-	3867, 3895, 3895, 3897, 3897, 3899, 3899, 3904, 3905, 3955, 3974, 3976,
-	3977, 3986, 3993, 3995, 4030, 4040, 4040, 4142, 4148, 4152, 4155, 4184,
-	4187, 6070, 6101, 6315, 6315, 8402, 8414, 8419, 8419, 12332, 12337, 12443,
-	12444, 64288, 64288, 65058, 65061, 22, 2, 50, 59, 1634, 1643, 1778, 1787,
-	2408, 2417, 2536, 2545, 2664, 2673, 2792, 2801, 2920, 2929, 3049, 3057,
-	3176, 3185, 3304, 3313, 3432, 3441, 3666, 3675, 3794, 3803, 3874, 3883,
-	4162, 4171, 4971, 4979, 6114, 6123, 6162, 6171, 65298, 65307, 9, 2, 97,
-	97, 8257, 8258, 12541, 12541, 65077, 65078, 65103, 65105, 65345, 65345,
-	65383, 65383, 2, 569, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2,
-	2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2,
-	2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3,
-	2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31,
-	3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2,
-	39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2,
-	2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2,
-	2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2,
-	2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3,
-	2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77,
-	3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2,
-	85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2,
-	2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2,
-	2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107,
-	3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2,
-	2, 115, 3, 2, 2, 2, 3, 161, 3, 2, 2, 2, 5, 164, 3, 2, 2, 2, 7, 168, 3,
-	2, 2, 2, 9, 172, 3, 2, 2, 2, 11, 177, 3, 2, 2, 2, 13, 181, 3, 2, 2, 2,
-	15, 185, 3, 2, 2, 2, 17, 192, 3, 2, 2, 2, 19, 196, 3, 2, 2, 2, 21, 198,
-	3, 2, 2, 2, 23, 200, 3, 2, 2, 2, 25, 202, 3, 2, 2, 2, 27, 204, 3, 2, 2,
-	2, 29, 206, 3, 2, 2, 2, 31, 208, 3, 2, 2, 2, 33, 210, 3, 2, 2, 2, 35, 212,
-	3, 2, 2, 2, 37, 214, 3, 2, 2, 2, 39, 216, 3, 2, 2, 2, 41, 218, 3, 2, 2,
-	2, 43, 220, 3, 2, 2, 2, 45, 222, 3, 2, 2, 2, 47, 228, 3, 2, 2, 2, 49, 230,
-	3, 2, 2, 2, 51, 232, 3, 2, 2, 2, 53, 235, 3, 2, 2, 2, 55, 237, 3, 2, 2,
-	2, 57, 239, 3, 2, 2, 2, 59, 242, 3, 2, 2, 2, 61, 245, 3, 2, 2, 2, 63, 247,
-	3, 2, 2, 2, 65, 249, 3, 2, 2, 2, 67, 252, 3, 2, 2, 2, 69, 255, 3, 2, 2,
-	2, 71, 258, 3, 2, 2, 2, 73, 261, 3, 2, 2, 2, 75, 268, 3, 2, 2, 2, 77, 274,
-	3, 2, 2, 2, 79, 298, 3, 2, 2, 2, 81, 300, 3, 2, 2, 2, 83, 311, 3, 2, 2,
-	2, 85, 320, 3, 2, 2, 2, 87, 329, 3, 2, 2, 2, 89, 337, 3, 2, 2, 2, 91, 340,
-	3, 2, 2, 2, 93, 347, 3, 2, 2, 2, 95, 360, 3, 2, 2, 2, 97, 370, 3, 2, 2,
-	2, 99, 385, 3, 2, 2, 2, 101, 387, 3, 2, 2, 2, 103, 394, 3, 2, 2, 2, 105,
-	417, 3, 2, 2, 2, 107, 420, 3, 2, 2, 2, 109, 426, 3, 2, 2, 2, 111, 440,
-	3, 2, 2, 2, 113, 451, 3, 2, 2, 2, 115, 455, 3, 2, 2, 2, 117, 461, 3, 2,
-	2, 2, 119, 467, 3, 2, 2, 2, 121, 473, 3, 2, 2, 2, 123, 477, 3, 2, 2, 2,
-	125, 479, 3, 2, 2, 2, 127, 483, 3, 2, 2, 2, 129, 489, 3, 2, 2, 2, 131,
-	491, 3, 2, 2, 2, 133, 496, 3, 2, 2, 2, 135, 498, 3, 2, 2, 2, 137, 504,
-	3, 2, 2, 2, 139, 506, 3, 2, 2, 2, 141, 508, 3, 2, 2, 2, 143, 518, 3, 2,
-	2, 2, 145, 524, 3, 2, 2, 2, 147, 532, 3, 2, 2, 2, 149, 535, 3, 2, 2, 2,
-	151, 538, 3, 2, 2, 2, 153, 541, 3, 2, 2, 2, 155, 544, 3, 2, 2, 2, 157,
-	546, 3, 2, 2, 2, 159, 548, 3, 2, 2, 2, 161, 162, 7, 48, 2, 2, 162, 163,
-	7, 48, 2, 2, 163, 4, 3, 2, 2, 2, 164, 165, 7, 110, 2, 2, 165, 166, 7, 103,
-	2, 2, 166, 167, 7, 112, 2, 2, 167, 6, 3, 2, 2, 2, 168, 169, 7, 99, 2, 2,
-	169, 170, 7, 110, 2, 2, 170, 171, 7, 110, 2, 2, 171, 8, 3, 2, 2, 2, 172,
-	173, 7, 112, 2, 2, 173, 174, 7, 113, 2, 2, 174, 175, 7, 112, 2, 2, 175,
-	176, 7, 103, 2, 2, 176, 10, 3, 2, 2, 2, 177, 178, 7, 99, 2, 2, 178, 179,
-	7, 112, 2, 2, 179, 180, 7, 123, 2, 2, 180, 12, 3, 2, 2, 2, 181, 182, 7,
-	113, 2, 2, 182, 183, 7, 112, 2, 2, 183, 184, 7, 103, 2, 2, 184, 14, 3,
-	2, 2, 2, 185, 186, 7, 104, 2, 2, 186, 187, 7, 107, 2, 2, 187, 188, 7, 110,
-	2, 2, 188, 189, 7, 118, 2, 2, 189, 190, 7, 103, 2, 2, 190, 191, 7, 116,
-	2, 2, 191, 16, 3, 2, 2, 2, 192, 193, 7, 111, 2, 2, 193, 194, 7, 99, 2,
-	2, 194, 195, 7, 114, 2, 2, 195, 18, 3, 2, 2, 2, 196, 197, 7, 93, 2, 2,
-	197, 20, 3, 2, 2, 2, 198, 199, 7, 95, 2, 2, 199, 22, 3, 2, 2, 2, 200, 201,
-	7, 42, 2, 2, 201, 24, 3, 2, 2, 2, 202, 203, 7, 43, 2, 2, 203, 26, 3, 2,
-	2, 2, 204, 205, 7, 125, 2, 2, 205, 28, 3, 2, 2, 2, 206, 207, 7, 127, 2,
-	2, 207, 30, 3, 2, 2, 2, 208, 209, 7, 61, 2, 2, 209, 32, 3, 2, 2, 2, 210,
-	211, 7, 46, 2, 2, 211, 34, 3, 2, 2, 2, 212, 213, 7, 63, 2, 2, 213, 36,
-	3, 2, 2, 2, 214, 215, 7, 65, 2, 2, 215, 38, 3, 2, 2, 2, 216, 217, 7, 60,
-	2, 2, 217, 40, 3, 2, 2, 2, 218, 219, 7, 48, 2, 2, 219, 42, 3, 2, 2, 2,
-	220, 221, 7, 45, 2, 2, 221, 44, 3, 2, 2, 2, 222, 223, 7, 47, 2, 2, 223,
-	46, 3, 2, 2, 2, 224, 229, 7, 35, 2, 2, 225, 226, 7, 112, 2, 2, 226, 227,
-	7, 113, 2, 2, 227, 229, 7, 118, 2, 2, 228, 224, 3, 2, 2, 2, 228, 225, 3,
-	2, 2, 2, 229, 48, 3, 2, 2, 2, 230, 231, 7, 44, 2, 2, 231, 50, 3, 2, 2,
-	2, 232, 233, 7, 44, 2, 2, 233, 234, 7, 44, 2, 2, 234, 52, 3, 2, 2, 2, 235,
-	236, 7, 49, 2, 2, 236, 54, 3, 2, 2, 2, 237, 238, 7, 39, 2, 2, 238, 56,
-	3, 2, 2, 2, 239, 240, 7, 64, 2, 2, 240, 241, 7, 64, 2, 2, 241, 58, 3, 2,
-	2, 2, 242, 243, 7, 62, 2, 2, 243, 244, 7, 62, 2, 2, 244, 60, 3, 2, 2, 2,
-	245, 246, 7, 62, 2, 2, 246, 62, 3, 2, 2, 2, 247, 248, 7, 64, 2, 2, 248,
-	64, 3, 2, 2, 2, 249, 250, 7, 62, 2, 2, 250, 251, 7, 63, 2, 2, 251, 66,
-	3, 2, 2, 2, 252, 253, 7, 64, 2, 2, 253, 254, 7, 63, 2, 2, 254, 68, 3, 2,
-	2, 2, 255, 256, 7, 63, 2, 2, 256, 257, 7, 63, 2, 2, 257, 70, 3, 2, 2, 2,
-	258, 259, 7, 35, 2, 2, 259, 260, 7, 63, 2, 2, 260, 72, 3, 2, 2, 2, 261,
-	262, 7, 37, 2, 2, 262, 74, 3, 2, 2, 2, 263, 264, 7, 40, 2, 2, 264, 269,
-	7, 40, 2, 2, 265, 266, 7, 99, 2, 2, 266, 267, 7, 112, 2, 2, 267, 269, 7,
-	102, 2, 2, 268, 263, 3, 2, 2, 2, 268, 265, 3, 2, 2, 2, 269, 76, 3, 2, 2,
-	2, 270, 271, 7, 126, 2, 2, 271, 275, 7, 126, 2, 2, 272, 273, 7, 113, 2,
-	2, 273, 275, 7, 116, 2, 2, 274, 270, 3, 2, 2, 2, 274, 272, 3, 2, 2, 2,
-	275, 78, 3, 2, 2, 2, 276, 277, 7, 99, 2, 2, 277, 278, 7, 110, 2, 2, 278,
-	299, 7, 110, 2, 2, 279, 280, 7, 112, 2, 2, 280, 281, 7, 113, 2, 2, 281,
-	282, 7, 112, 2, 2, 282, 299, 7, 103, 2, 2, 283, 284, 7, 99, 2, 2, 284,
-	285, 7, 112, 2, 2, 285, 299, 7, 123, 2, 2, 286, 287, 7, 113, 2, 2, 287,
-	288, 7, 112, 2, 2, 288, 299, 7, 103, 2, 2, 289, 290, 7, 104, 2, 2, 290,
-	291, 7, 107, 2, 2, 291, 292, 7, 110, 2, 2, 292, 293, 7, 118, 2, 2, 293,
-	294, 7, 103, 2, 2, 294, 299, 7, 116, 2, 2, 295, 296, 7, 111, 2, 2, 296,
-	297, 7, 99, 2, 2, 297, 299, 7, 114, 2, 2, 298, 276, 3, 2, 2, 2, 298, 279,
-	3, 2, 2, 2, 298, 283, 3, 2, 2, 2, 298, 286, 3, 2, 2, 2, 298, 289, 3, 2,
-	2, 2, 298, 295, 3, 2, 2, 2, 299, 80, 3, 2, 2, 2, 300, 301, 7, 117, 2, 2,
-	301, 302, 7, 118, 2, 2, 302, 303, 7, 99, 2, 2, 303, 304, 7, 116, 2, 2,
-	304, 305, 7, 118, 2, 2, 305, 306, 7, 117, 2, 2, 306, 307, 7, 89, 2, 2,
-	307, 308, 7, 107, 2, 2, 308, 309, 7, 118, 2, 2, 309, 310, 7, 106, 2, 2,
-	310, 82, 3, 2, 2, 2, 311, 312, 7, 103, 2, 2, 312, 313, 7, 112, 2, 2, 313,
-	314, 7, 102, 2, 2, 314, 315, 7, 117, 2, 2, 315, 316, 7, 89, 2, 2, 316,
-	317, 7, 107, 2, 2, 317, 318, 7, 118, 2, 2, 318, 319, 7, 106, 2, 2, 319,
-	84, 3, 2, 2, 2, 320, 321, 7, 101, 2, 2, 321, 322, 7, 113, 2, 2, 322, 323,
-	7, 112, 2, 2, 323, 324, 7, 118, 2, 2, 324, 325, 7, 99, 2, 2, 325, 326,
-	7, 107, 2, 2, 326, 327, 7, 112, 2, 2, 327, 328, 7, 117, 2, 2, 328, 86,
-	3, 2, 2, 2, 329, 330, 7, 111, 2, 2, 330, 331, 7, 99, 2, 2, 331, 332, 7,
-	118, 2, 2, 332, 333, 7, 101, 2, 2, 333, 334, 7, 106, 2, 2, 334, 335, 7,
-	103, 2, 2, 335, 336, 7, 117, 2, 2, 336, 88, 3, 2, 2, 2, 337, 338, 7, 107,
-	2, 2, 338, 339, 7, 112, 2, 2, 339, 90, 3, 2, 2, 2, 340, 341, 7, 112, 2,
-	2, 341, 342, 7, 113, 2, 2, 342, 343, 7, 118, 2, 2, 343, 344, 7, 34, 2,
-	2, 344, 345, 7, 107, 2, 2, 345, 346, 7, 112, 2, 2, 346, 92, 3, 2, 2, 2,
-	347, 348, 7, 112, 2, 2, 348, 349, 7, 107, 2, 2, 349, 350, 7, 110, 2, 2,
-	350, 94, 3, 2, 2, 2, 351, 352, 7, 118, 2, 2, 352, 353, 7, 116, 2, 2, 353,
-	354, 7, 119, 2, 2, 354, 361, 7, 103, 2, 2, 355, 356, 7, 104, 2, 2, 356,
-	357, 7, 99, 2, 2, 357, 358, 7, 110, 2, 2, 358, 359, 7, 117, 2, 2, 359,
-	361, 7, 103, 2, 2, 360, 351, 3, 2, 2, 2, 360, 355, 3, 2, 2, 2, 361, 96,
-	3, 2, 2, 2, 362, 371, 7, 50, 2, 2, 363, 367, 9, 2, 2, 2, 364, 366, 9, 3,
-	2, 2, 365, 364, 3, 2, 2, 2, 366, 369, 3, 2, 2, 2, 367, 365, 3, 2, 2, 2,
-	367, 368, 3, 2, 2, 2, 368, 371, 3, 2, 2, 2, 369, 367, 3, 2, 2, 2, 370,
-	362, 3, 2, 2, 2, 370, 363, 3, 2, 2, 2, 371, 98, 3, 2, 2, 2, 372, 373, 5,
-	143, 72, 2, 373, 375, 7, 48, 2, 2, 374, 376, 5, 139, 70, 2, 375, 374, 3,
-	2, 2, 2, 376, 377, 3, 2, 2, 2, 377, 375, 3, 2, 2, 2, 377, 378, 3, 2, 2,
-	2, 378, 386, 3, 2, 2, 2, 379, 381, 7, 48, 2, 2, 380, 382, 5, 139, 70, 2,
-	381, 380, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 381, 3, 2, 2, 2, 383,
-	384, 3, 2, 2, 2, 384, 386, 3, 2, 2, 2, 385, 372, 3, 2, 2, 2, 385, 379,
-	3, 2, 2, 2, 386, 100, 3, 2, 2, 2, 387, 388, 7, 50, 2, 2, 388, 390, 9, 4,
-	2, 2, 389, 391, 5, 141, 71, 2, 390, 389, 3, 2, 2, 2, 391, 392, 3, 2, 2,
-	2, 392, 390, 3, 2, 2, 2, 392, 393, 3, 2, 2, 2, 393, 102, 3, 2, 2, 2, 394,
-	398, 5, 145, 73, 2, 395, 397, 5, 147, 74, 2, 396, 395, 3, 2, 2, 2, 397,
-	400, 3, 2, 2, 2, 398, 396, 3, 2, 2, 2, 398, 399, 3, 2, 2, 2, 399, 104,
-	3, 2, 2, 2, 400, 398, 3, 2, 2, 2, 401, 405, 7, 36, 2, 2, 402, 404, 5, 117,
-	59, 2, 403, 402, 3, 2, 2, 2, 404, 407, 3, 2, 2, 2, 405, 403, 3, 2, 2, 2,
-	405, 406, 3, 2, 2, 2, 406, 408, 3, 2, 2, 2, 407, 405, 3, 2, 2, 2, 408,
-	418, 7, 36, 2, 2, 409, 413, 7, 41, 2, 2, 410, 412, 5, 119, 60, 2, 411,
-	410, 3, 2, 2, 2, 412, 415, 3, 2, 2, 2, 413, 411, 3, 2, 2, 2, 413, 414,
-	3, 2, 2, 2, 414, 416, 3, 2, 2, 2, 415, 413, 3, 2, 2, 2, 416, 418, 7, 41,
-	2, 2, 417, 401, 3, 2, 2, 2, 417, 409, 3, 2, 2, 2, 418, 106, 3, 2, 2, 2,
-	419, 421, 9, 5, 2, 2, 420, 419, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2, 422,
-	420, 3, 2, 2, 2, 422, 423, 3, 2, 2, 2, 423, 424, 3, 2, 2, 2, 424, 425,
-	8, 54, 2, 2, 425, 108, 3, 2, 2, 2, 426, 427, 7, 49, 2, 2, 427, 428, 7,
-	44, 2, 2, 428, 432, 3, 2, 2, 2, 429, 431, 11, 2, 2, 2, 430, 429, 3, 2,
-	2, 2, 431, 434, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 432, 430, 3, 2, 2, 2,
-	433, 435, 3, 2, 2, 2, 434, 432, 3, 2, 2, 2, 435, 436, 7, 44, 2, 2, 436,
-	437, 7, 49, 2, 2, 437, 438, 3, 2, 2, 2, 438, 439, 8, 55, 2, 2, 439, 110,
-	3, 2, 2, 2, 440, 441, 7, 49, 2, 2, 441, 442, 7, 49, 2, 2, 442, 446, 3,
-	2, 2, 2, 443, 445, 10, 6, 2, 2, 444, 443, 3, 2, 2, 2, 445, 448, 3, 2, 2,
-	2, 446, 444, 3, 2, 2, 2, 446, 447, 3, 2, 2, 2, 447, 449, 3, 2, 2, 2, 448,
-	446, 3, 2, 2, 2, 449, 450, 8, 56, 2, 2, 450, 112, 3, 2, 2, 2, 451, 452,
-	9, 6, 2, 2, 452, 453, 3, 2, 2, 2, 453, 454, 8, 57, 2, 2, 454, 114, 3, 2,
-	2, 2, 455, 456, 11, 2, 2, 2, 456, 116, 3, 2, 2, 2, 457, 462, 10, 7, 2,
-	2, 458, 459, 7, 94, 2, 2, 459, 462, 5, 121, 61, 2, 460, 462, 5, 135, 68,
-	2, 461, 457, 3, 2, 2, 2, 461, 458, 3, 2, 2, 2, 461, 460, 3, 2, 2, 2, 462,
-	118, 3, 2, 2, 2, 463, 468, 10, 8, 2, 2, 464, 465, 7, 94, 2, 2, 465, 468,
-	5, 121, 61, 2, 466, 468, 5, 135, 68, 2, 467, 463, 3, 2, 2, 2, 467, 464,
-	3, 2, 2, 2, 467, 466, 3, 2, 2, 2, 468, 120, 3, 2, 2, 2, 469, 474, 5, 123,
-	62, 2, 470, 474, 7, 50, 2, 2, 471, 474, 5, 125, 63, 2, 472, 474, 5, 127,
-	64, 2, 473, 469, 3, 2, 2, 2, 473, 470, 3, 2, 2, 2, 473, 471, 3, 2, 2, 2,
-	473, 472, 3, 2, 2, 2, 474, 122, 3, 2, 2, 2, 475, 478, 5, 129, 65, 2, 476,
-	478, 5, 131, 66, 2, 477, 475, 3, 2, 2, 2, 477, 476, 3, 2, 2, 2, 478, 124,
-	3, 2, 2, 2, 479, 480, 7, 122, 2, 2, 480, 481, 5, 141, 71, 2, 481, 482,
-	5, 141, 71, 2, 482, 126, 3, 2, 2, 2, 483, 484, 7, 119, 2, 2, 484, 485,
-	5, 141, 71, 2, 485, 486, 5, 141, 71, 2, 486, 487, 5, 141, 71, 2, 487, 488,
-	5, 141, 71, 2, 488, 128, 3, 2, 2, 2, 489, 490, 9, 9, 2, 2, 490, 130, 3,
-	2, 2, 2, 491, 492, 10, 10, 2, 2, 492, 132, 3, 2, 2, 2, 493, 497, 5, 129,
-	65, 2, 494, 497, 5, 139, 70, 2, 495, 497, 9, 11, 2, 2, 496, 493, 3, 2,
-	2, 2, 496, 494, 3, 2, 2, 2, 496, 495, 3, 2, 2, 2, 497, 134, 3, 2, 2, 2,
-	498, 499, 7, 94, 2, 2, 499, 500, 5, 137, 69, 2, 500, 136, 3, 2, 2, 2, 501,
-	502, 7, 15, 2, 2, 502, 505, 7, 12, 2, 2, 503, 505, 5, 113, 57, 2, 504,
-	501, 3, 2, 2, 2, 504, 503, 3, 2, 2, 2, 505, 138, 3, 2, 2, 2, 506, 507,
-	9, 12, 2, 2, 507, 140, 3, 2, 2, 2, 508, 509, 9, 13, 2, 2, 509, 142, 3,
-	2, 2, 2, 510, 519, 7, 50, 2, 2, 511, 515, 9, 2, 2, 2, 512, 514, 5, 139,
-	70, 2, 513, 512, 3, 2, 2, 2, 514, 517, 3, 2, 2, 2, 515, 513, 3, 2, 2, 2,
-	515, 516, 3, 2, 2, 2, 516, 519, 3, 2, 2, 2, 517, 515, 3, 2, 2, 2, 518,
-	510, 3, 2, 2, 2, 518, 511, 3, 2, 2, 2, 519, 144, 3, 2, 2, 2, 520, 525,
-	5, 149, 75, 2, 521, 525, 9, 14, 2, 2, 522, 523, 7, 94, 2, 2, 523, 525,
-	5, 127, 64, 2, 524, 520, 3, 2, 2, 2, 524, 521, 3, 2, 2, 2, 524, 522, 3,
-	2, 2, 2, 525, 146, 3, 2, 2, 2, 526, 533, 5, 145, 73, 2, 527, 533, 5, 151,
-	76, 2, 528, 533, 5, 153, 77, 2, 529, 533, 5, 155, 78, 2, 530, 533, 5, 157,
-	79, 2, 531, 533, 5, 159, 80, 2, 532, 526, 3, 2, 2, 2, 532, 527, 3, 2, 2,
-	2, 532, 528, 3, 2, 2, 2, 532, 529, 3, 2, 2, 2, 532, 530, 3, 2, 2, 2, 532,
-	531, 3, 2, 2, 2, 533, 148, 3, 2, 2, 2, 534, 536, 9, 15, 2, 2, 535, 534,
-	3, 2, 2, 2, 536, 150, 3, 2, 2, 2, 537, 539, 9, 16, 2, 2, 538, 537, 3, 2,
-	2, 2, 539, 152, 3, 2, 2, 2, 540, 542, 9, 17, 2, 2, 541, 540, 3, 2, 2, 2,
-	542, 154, 3, 2, 2, 2, 543, 545, 9, 18, 2, 2, 544, 543, 3, 2, 2, 2, 545,
-	156, 3, 2, 2, 2, 546, 547, 7, 8206, 2, 2, 547, 158, 3, 2, 2, 2, 548, 549,
-	7, 8207, 2, 2, 549, 160, 3, 2, 2, 2, 35, 2, 228, 268, 274, 298, 360, 367,
-	370, 377, 383, 385, 392, 398, 405, 413, 417, 422, 432, 446, 461, 467, 473,
-	477, 496, 504, 515, 518, 524, 532, 535, 538, 541, 544, 3, 2, 3, 2,
+	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 3, 2, 3, 2, 3,
+	3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3,
+	9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13,
+	3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3,
+	17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20,
+	5, 20, 206, 10, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3,
+	24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29,
+	3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3,
+	33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36,
+	3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 5, 38, 252, 10, 38, 3, 39, 3, 39, 3,
+	39, 3, 39, 3, 39, 5, 39, 259, 10, 39, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40,
+	265, 10, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
+	41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42,
+	3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
+	44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45,
+	3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3,
+	47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
+	3, 49, 3, 49, 3, 49, 5, 49, 331, 10, 49, 3, 50, 3, 50, 7, 50, 335, 10,
+	50, 12, 50, 14, 50, 338, 11, 50, 3, 51, 3, 51, 7, 51, 342, 10, 51, 12,
+	51, 14, 51, 345, 11, 51, 3, 51, 3, 51, 3, 51, 7, 51, 350, 10, 51, 12, 51,
+	14, 51, 353, 11, 51, 3, 51, 5, 51, 356, 10, 51, 3, 51, 3, 51, 3, 51, 7,
+	51, 361, 10, 51, 12, 51, 14, 51, 364, 11, 51, 3, 51, 5, 51, 367, 10, 51,
+	3, 51, 3, 51, 7, 51, 371, 10, 51, 12, 51, 14, 51, 374, 11, 51, 3, 51, 3,
+	51, 5, 51, 378, 10, 51, 3, 52, 3, 52, 3, 52, 7, 52, 383, 10, 52, 12, 52,
+	14, 52, 386, 11, 52, 3, 53, 3, 53, 3, 53, 7, 53, 391, 10, 53, 12, 53, 14,
+	53, 394, 11, 53, 3, 54, 3, 54, 3, 54, 7, 54, 399, 10, 54, 12, 54, 14, 54,
+	402, 11, 54, 3, 55, 6, 55, 405, 10, 55, 13, 55, 14, 55, 406, 3, 55, 3,
+	55, 6, 55, 411, 10, 55, 13, 55, 14, 55, 412, 5, 55, 415, 10, 55, 3, 55,
+	3, 55, 6, 55, 419, 10, 55, 13, 55, 14, 55, 420, 3, 55, 7, 55, 424, 10,
+	55, 12, 55, 14, 55, 427, 11, 55, 3, 56, 3, 56, 3, 57, 3, 57, 7, 57, 433,
+	10, 57, 12, 57, 14, 57, 436, 11, 57, 3, 57, 3, 57, 3, 57, 7, 57, 441, 10,
+	57, 12, 57, 14, 57, 444, 11, 57, 3, 57, 3, 57, 3, 57, 7, 57, 449, 10, 57,
+	12, 57, 14, 57, 452, 11, 57, 3, 57, 5, 57, 455, 10, 57, 3, 58, 6, 58, 458,
+	10, 58, 13, 58, 14, 58, 459, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59,
+	7, 59, 468, 10, 59, 12, 59, 14, 59, 471, 11, 59, 3, 59, 3, 59, 3, 59, 3,
+	59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 7, 60, 482, 10, 60, 12, 60, 14,
+	60, 485, 11, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62,
+	3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 5, 63, 500, 10, 63, 5, 63, 502, 10,
+	63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 5, 64, 509, 10, 64, 5, 64, 511,
+	10, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 67,
+	3, 67, 3, 68, 3, 68, 3, 69, 3, 69, 5, 69, 527, 10, 69, 3, 69, 3, 69, 7,
+	69, 531, 10, 69, 12, 69, 14, 69, 534, 11, 69, 3, 70, 3, 70, 3, 70, 3, 70,
+	3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 5, 70, 545, 10, 70, 3, 71, 3, 71, 7,
+	71, 549, 10, 71, 12, 71, 14, 71, 552, 11, 71, 3, 72, 3, 72, 3, 72, 3, 72,
+	5, 72, 558, 10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 5, 73, 566,
+	10, 73, 3, 74, 5, 74, 569, 10, 74, 3, 75, 5, 75, 572, 10, 75, 3, 76, 5,
+	76, 575, 10, 76, 3, 77, 5, 77, 578, 10, 77, 3, 78, 3, 78, 3, 79, 3, 79,
+	3, 469, 2, 80, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19,
+	11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37,
+	20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55,
+	29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73,
+	38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91,
+	47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55,
+	109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63,
+	125, 2, 127, 2, 129, 2, 131, 2, 133, 2, 135, 2, 137, 2, 139, 2, 141, 2,
+	143, 2, 145, 2, 147, 2, 149, 2, 151, 2, 153, 2, 155, 2, 157, 2, 3, 2, 22,
+	4, 2, 90, 90, 122, 122, 6, 2, 50, 59, 67, 72, 97, 97, 99, 104, 4, 2, 81,
+	81, 113, 113, 4, 2, 68, 68, 100, 100, 3, 2, 98, 98, 6, 2, 11, 11, 13, 14,
+	34, 34, 162, 162, 5, 2, 12, 12, 15, 15, 8234, 8235, 6, 2, 12, 12, 15, 15,
+	36, 36, 94, 94, 6, 2, 12, 12, 15, 15, 41, 41, 94, 94, 3, 2, 50, 59, 4,
+	2, 50, 59, 97, 97, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71, 103, 103,
+	4, 2, 45, 45, 47, 47, 5, 2, 106, 106, 111, 111, 117, 117, 4, 2, 38, 38,
+	97, 97, 260, 2, 67, 92, 99, 124, 172, 172, 183, 183, 188, 188, 194, 216,
+	218, 248, 250, 545, 548, 565, 594, 687, 690, 698, 701, 707, 722, 723, 738,
+	742, 752, 752, 892, 892, 904, 904, 906, 908, 910, 910, 912, 931, 933, 976,
+	978, 985, 988, 1013, 1026, 1155, 1166, 1222, 1225, 1226, 1229, 1230, 1234,
+	1271, 1274, 1275, 1331, 1368, 1371, 1371, 1379, 1417, 1490, 1516, 1522,
+	1524, 1571, 1596, 1602, 1612, 1651, 1749, 1751, 1751, 1767, 1768, 1788,
+	1790, 1810, 1810, 1812, 1838, 1922, 1959, 2311, 2363, 2367, 2367, 2386,
+	2386, 2394, 2403, 2439, 2446, 2449, 2450, 2453, 2474, 2476, 2482, 2484,
+	2484, 2488, 2491, 2526, 2527, 2529, 2531, 2546, 2547, 2567, 2572, 2577,
+	2578, 2581, 2602, 2604, 2610, 2612, 2613, 2615, 2616, 2618, 2619, 2651,
+	2654, 2656, 2656, 2676, 2678, 2695, 2701, 2703, 2703, 2705, 2707, 2709,
+	2730, 2732, 2738, 2740, 2741, 2743, 2747, 2751, 2751, 2770, 2770, 2786,
+	2786, 2823, 2830, 2833, 2834, 2837, 2858, 2860, 2866, 2868, 2869, 2872,
+	2875, 2879, 2879, 2910, 2911, 2913, 2915, 2951, 2956, 2960, 2962, 2964,
+	2967, 2971, 2972, 2974, 2974, 2976, 2977, 2981, 2982, 2986, 2988, 2992,
+	2999, 3001, 3003, 3079, 3086, 3088, 3090, 3092, 3114, 3116, 3125, 3127,
+	3131, 3170, 3171, 3207, 3214, 3216, 3218, 3220, 3242, 3244, 3253, 3255,
+	3259, 3296, 3296, 3298, 3299, 3335, 3342, 3344, 3346, 3348, 3370, 3372,
+	3387, 3426, 3427, 3463, 3480, 3484, 3507, 3509, 3517, 3519, 3519, 3522,
+	3528, 3587, 3634, 3636, 3637, 3650, 3656, 3715, 3716, 3718, 3718, 3721,
+	3722, 3724, 3724, 3727, 3727, 3734, 3737, 3739, 3745, 3747, 3749, 3751,
+	3751, 3753, 3753, 3756, 3757, 3759, 3762, 3764, 3765, 3775, 3782, 3784,
+	3784, 3806, 3807, 3842, 3842, 3906, 3948, 3978, 3981, 4098, 4131, 4133,
+	4137, 4139, 4140, 4178, 4183, 4258, 4295, 4306, 4344, 4354, 4443, 4449,
+	4516, 4522, 4603, 4610, 4616, 4618, 4680, 4682, 4682, 4684, 4687, 4690,
+	4696, 4698, 4698, 4700, 4703, 4706, 4744, 4746, 4746, 4748, 4751, 4754,
+	4784, 4786, 4786, 4788, 4791, 4794, 4800, 4802, 4802, 4804, 4807, 4810,
+	4816, 4818, 4824, 4826, 4848, 4850, 4880, 4882, 4882, 4884, 4887, 4890,
+	4896, 4898, 4936, 4938, 4956, 5026, 5110, 5123, 5752, 5763, 5788, 5794,
+	5868, 6018, 6069, 6178, 6265, 6274, 6314, 7682, 7837, 7842, 7931, 7938,
+	7959, 7962, 7967, 7970, 8007, 8010, 8015, 8018, 8025, 8027, 8027, 8029,
+	8029, 8031, 8031, 8033, 8063, 8066, 8118, 8120, 8126, 8128, 8128, 8132,
+	8134, 8136, 8142, 8146, 8149, 8152, 8157, 8162, 8174, 8180, 8182, 8184,
+	8190, 8321, 8321, 8452, 8452, 8457, 8457, 8460, 8469, 8471, 8471, 8475,
+	8479, 8486, 8486, 8488, 8488, 8490, 8490, 8492, 8495, 8497, 8499, 8501,
+	8507, 8546, 8581, 12295, 12297, 12323, 12331, 12339, 12343, 12346, 12348,
+	12355, 12438, 12447, 12448, 12451, 12540, 12542, 12544, 12551, 12590, 12595,
+	12688, 12706, 12729, 13314, 13314, 19895, 19895, 19970, 19970, 40871, 40871,
+	40962, 42126, 44034, 44034, 55205, 55205, 63746, 64047, 64258, 64264, 64277,
+	64281, 64287, 64287, 64289, 64298, 64300, 64312, 64314, 64318, 64320, 64320,
+	64322, 64323, 64325, 64326, 64328, 64435, 64469, 64831, 64850, 64913, 64916,
+	64969, 65010, 65021, 65138, 65140, 65142, 65142, 65144, 65278, 65315, 65340,
+	65347, 65372, 65384, 65472, 65476, 65481, 65484, 65489, 65492, 65497, 65500,
+	65502, 102, 2, 770, 848, 866, 868, 1157, 1160, 1427, 1443, 1445, 1467,
+	1469, 1471, 1473, 1473, 1475, 1476, 1478, 1478, 1613, 1623, 1650, 1650,
+	1752, 1758, 1761, 1766, 1769, 1770, 1772, 1775, 1811, 1811, 1842, 1868,
+	1960, 1970, 2307, 2309, 2366, 2366, 2368, 2383, 2387, 2390, 2404, 2405,
+	2435, 2437, 2494, 2502, 2505, 2506, 2509, 2511, 2521, 2521, 2532, 2533,
+	2564, 2564, 2622, 2622, 2624, 2628, 2633, 2634, 2637, 2639, 2674, 2675,
+	2691, 2693, 2750, 2750, 2752, 2759, 2761, 2763, 2765, 2767, 2819, 2821,
+	2878, 2878, 2880, 2885, 2889, 2890, 2893, 2895, 2904, 2905, 2948, 2949,
+	3008, 3012, 3016, 3018, 3020, 3023, 3033, 3033, 3075, 3077, 3136, 3142,
+	3144, 3146, 3148, 3151, 3159, 3160, 3204, 3205, 3264, 3270, 3272, 3274,
+	3276, 3279, 3287, 3288, 3332, 3333, 3392, 3397, 3400, 3402, 3404, 3407,
+	3417, 3417, 3460, 3461, 3532, 3532, 3537, 3542, 3544, 3544, 3546, 3553,
+	3572, 3573, 3635, 3635, 3638, 3644, 3657, 3664, 3763, 3763, 3766, 3771,
+	3773, 3774, 3786, 3791, 3866, 3867, 3895, 3895, 3897, 3897, 3899, 3899,
+	3904, 3905, 3955, 3974, 3976, 3977, 3986, 3993, 3995, 4030, 4040, 4040,
+	4142, 4148, 4152, 4155, 4184, 4187, 6070, 6101, 6315, 6315, 8402, 8414,
+	8419, 8419, 12332, 12337, 12443, 12444, 64288, 64288, 65058, 65061, 22,
+	2, 50, 59, 1634, 1643, 1778, 1787, 2408, 2417, 2536, 2545, 2664, 2673,
+	2792, 2801, 2920, 2929, 3049, 3057, 3176, 3185, 3304, 3313, 3432, 3441,
+	3666, 3675, 3794, 3803, 3874, 3883, 4162, 4171, 4971, 4979, 6114, 6123,
+	6162, 6171, 65298, 65307, 9, 2, 97, 97, 8257, 8258, 12541, 12541, 65077,
+	65078, 65103, 65105, 65345, 65345, 65383, 65383, 2, 613, 2, 3, 3, 2, 2,
+	2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2,
+	2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2,
+	2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3,
+	2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35,
+	3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2,
+	43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2,
+	2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2,
+	2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2,
+	2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3,
+	2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81,
+	3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2,
+	89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2,
+	2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2,
+	2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111,
+	3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2,
+	2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 3, 159, 3,
+	2, 2, 2, 5, 161, 3, 2, 2, 2, 7, 163, 3, 2, 2, 2, 9, 165, 3, 2, 2, 2, 11,
+	167, 3, 2, 2, 2, 13, 169, 3, 2, 2, 2, 15, 171, 3, 2, 2, 2, 17, 173, 3,
+	2, 2, 2, 19, 175, 3, 2, 2, 2, 21, 177, 3, 2, 2, 2, 23, 180, 3, 2, 2, 2,
+	25, 182, 3, 2, 2, 2, 27, 187, 3, 2, 2, 2, 29, 190, 3, 2, 2, 2, 31, 192,
+	3, 2, 2, 2, 33, 194, 3, 2, 2, 2, 35, 197, 3, 2, 2, 2, 37, 199, 3, 2, 2,
+	2, 39, 205, 3, 2, 2, 2, 41, 207, 3, 2, 2, 2, 43, 209, 3, 2, 2, 2, 45, 211,
+	3, 2, 2, 2, 47, 214, 3, 2, 2, 2, 49, 216, 3, 2, 2, 2, 51, 218, 3, 2, 2,
+	2, 53, 220, 3, 2, 2, 2, 55, 222, 3, 2, 2, 2, 57, 224, 3, 2, 2, 2, 59, 227,
+	3, 2, 2, 2, 61, 230, 3, 2, 2, 2, 63, 233, 3, 2, 2, 2, 65, 235, 3, 2, 2,
+	2, 67, 237, 3, 2, 2, 2, 69, 240, 3, 2, 2, 2, 71, 243, 3, 2, 2, 2, 73, 246,
+	3, 2, 2, 2, 75, 249, 3, 2, 2, 2, 77, 258, 3, 2, 2, 2, 79, 264, 3, 2, 2,
+	2, 81, 266, 3, 2, 2, 2, 83, 277, 3, 2, 2, 2, 85, 286, 3, 2, 2, 2, 87, 295,
+	3, 2, 2, 2, 89, 303, 3, 2, 2, 2, 91, 306, 3, 2, 2, 2, 93, 313, 3, 2, 2,
+	2, 95, 317, 3, 2, 2, 2, 97, 330, 3, 2, 2, 2, 99, 332, 3, 2, 2, 2, 101,
+	377, 3, 2, 2, 2, 103, 379, 3, 2, 2, 2, 105, 387, 3, 2, 2, 2, 107, 395,
+	3, 2, 2, 2, 109, 418, 3, 2, 2, 2, 111, 428, 3, 2, 2, 2, 113, 454, 3, 2,
+	2, 2, 115, 457, 3, 2, 2, 2, 117, 463, 3, 2, 2, 2, 119, 477, 3, 2, 2, 2,
+	121, 488, 3, 2, 2, 2, 123, 492, 3, 2, 2, 2, 125, 501, 3, 2, 2, 2, 127,
+	510, 3, 2, 2, 2, 129, 512, 3, 2, 2, 2, 131, 518, 3, 2, 2, 2, 133, 520,
+	3, 2, 2, 2, 135, 522, 3, 2, 2, 2, 137, 524, 3, 2, 2, 2, 139, 544, 3, 2,
+	2, 2, 141, 546, 3, 2, 2, 2, 143, 557, 3, 2, 2, 2, 145, 565, 3, 2, 2, 2,
+	147, 568, 3, 2, 2, 2, 149, 571, 3, 2, 2, 2, 151, 574, 3, 2, 2, 2, 153,
+	577, 3, 2, 2, 2, 155, 579, 3, 2, 2, 2, 157, 581, 3, 2, 2, 2, 159, 160,
+	7, 93, 2, 2, 160, 4, 3, 2, 2, 2, 161, 162, 7, 95, 2, 2, 162, 6, 3, 2, 2,
+	2, 163, 164, 7, 42, 2, 2, 164, 8, 3, 2, 2, 2, 165, 166, 7, 43, 2, 2, 166,
+	10, 3, 2, 2, 2, 167, 168, 7, 125, 2, 2, 168, 12, 3, 2, 2, 2, 169, 170,
+	7, 127, 2, 2, 170, 14, 3, 2, 2, 2, 171, 172, 7, 61, 2, 2, 172, 16, 3, 2,
+	2, 2, 173, 174, 7, 46, 2, 2, 174, 18, 3, 2, 2, 2, 175, 176, 7, 63, 2, 2,
+	176, 20, 3, 2, 2, 2, 177, 178, 7, 63, 2, 2, 178, 179, 7, 64, 2, 2, 179,
+	22, 3, 2, 2, 2, 180, 181, 7, 65, 2, 2, 181, 24, 3, 2, 2, 2, 182, 183, 7,
+	65, 2, 2, 183, 184, 7, 48, 2, 2, 184, 185, 3, 2, 2, 2, 185, 186, 6, 13,
+	2, 2, 186, 26, 3, 2, 2, 2, 187, 188, 7, 65, 2, 2, 188, 189, 7, 65, 2, 2,
+	189, 28, 3, 2, 2, 2, 190, 191, 7, 60, 2, 2, 191, 30, 3, 2, 2, 2, 192, 193,
+	7, 48, 2, 2, 193, 32, 3, 2, 2, 2, 194, 195, 7, 48, 2, 2, 195, 196, 7, 48,
+	2, 2, 196, 34, 3, 2, 2, 2, 197, 198, 7, 45, 2, 2, 198, 36, 3, 2, 2, 2,
+	199, 200, 7, 47, 2, 2, 200, 38, 3, 2, 2, 2, 201, 206, 7, 35, 2, 2, 202,
+	203, 7, 112, 2, 2, 203, 204, 7, 113, 2, 2, 204, 206, 7, 118, 2, 2, 205,
+	201, 3, 2, 2, 2, 205, 202, 3, 2, 2, 2, 206, 40, 3, 2, 2, 2, 207, 208, 7,
+	128, 2, 2, 208, 42, 3, 2, 2, 2, 209, 210, 7, 44, 2, 2, 210, 44, 3, 2, 2,
+	2, 211, 212, 7, 44, 2, 2, 212, 213, 7, 44, 2, 2, 213, 46, 3, 2, 2, 2, 214,
+	215, 7, 49, 2, 2, 215, 48, 3, 2, 2, 2, 216, 217, 7, 39, 2, 2, 217, 50,
+	3, 2, 2, 2, 218, 219, 7, 40, 2, 2, 219, 52, 3, 2, 2, 2, 220, 221, 7, 126,
+	2, 2, 221, 54, 3, 2, 2, 2, 222, 223, 7, 96, 2, 2, 223, 56, 3, 2, 2, 2,
+	224, 225, 7, 40, 2, 2, 225, 226, 7, 96, 2, 2, 226, 58, 3, 2, 2, 2, 227,
+	228, 7, 64, 2, 2, 228, 229, 7, 64, 2, 2, 229, 60, 3, 2, 2, 2, 230, 231,
+	7, 62, 2, 2, 231, 232, 7, 62, 2, 2, 232, 62, 3, 2, 2, 2, 233, 234, 7, 62,
+	2, 2, 234, 64, 3, 2, 2, 2, 235, 236, 7, 64, 2, 2, 236, 66, 3, 2, 2, 2,
+	237, 238, 7, 62, 2, 2, 238, 239, 7, 63, 2, 2, 239, 68, 3, 2, 2, 2, 240,
+	241, 7, 64, 2, 2, 241, 242, 7, 63, 2, 2, 242, 70, 3, 2, 2, 2, 243, 244,
+	7, 63, 2, 2, 244, 245, 7, 63, 2, 2, 245, 72, 3, 2, 2, 2, 246, 247, 7, 35,
+	2, 2, 247, 248, 7, 63, 2, 2, 248, 74, 3, 2, 2, 2, 249, 251, 7, 37, 2, 2,
+	250, 252, 5, 141, 71, 2, 251, 250, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252,
+	76, 3, 2, 2, 2, 253, 254, 7, 40, 2, 2, 254, 259, 7, 40, 2, 2, 255, 256,
+	7, 99, 2, 2, 256, 257, 7, 112, 2, 2, 257, 259, 7, 102, 2, 2, 258, 253,
+	3, 2, 2, 2, 258, 255, 3, 2, 2, 2, 259, 78, 3, 2, 2, 2, 260, 261, 7, 126,
+	2, 2, 261, 265, 7, 126, 2, 2, 262, 263, 7, 113, 2, 2, 263, 265, 7, 116,
+	2, 2, 264, 260, 3, 2, 2, 2, 264, 262, 3, 2, 2, 2, 265, 80, 3, 2, 2, 2,
+	266, 267, 7, 117, 2, 2, 267, 268, 7, 118, 2, 2, 268, 269, 7, 99, 2, 2,
+	269, 270, 7, 116, 2, 2, 270, 271, 7, 118, 2, 2, 271, 272, 7, 117, 2, 2,
+	272, 273, 7, 89, 2, 2, 273, 274, 7, 107, 2, 2, 274, 275, 7, 118, 2, 2,
+	275, 276, 7, 106, 2, 2, 276, 82, 3, 2, 2, 2, 277, 278, 7, 103, 2, 2, 278,
+	279, 7, 112, 2, 2, 279, 280, 7, 102, 2, 2, 280, 281, 7, 117, 2, 2, 281,
+	282, 7, 89, 2, 2, 282, 283, 7, 107, 2, 2, 283, 284, 7, 118, 2, 2, 284,
+	285, 7, 106, 2, 2, 285, 84, 3, 2, 2, 2, 286, 287, 7, 101, 2, 2, 287, 288,
+	7, 113, 2, 2, 288, 289, 7, 112, 2, 2, 289, 290, 7, 118, 2, 2, 290, 291,
+	7, 99, 2, 2, 291, 292, 7, 107, 2, 2, 292, 293, 7, 112, 2, 2, 293, 294,
+	7, 117, 2, 2, 294, 86, 3, 2, 2, 2, 295, 296, 7, 111, 2, 2, 296, 297, 7,
+	99, 2, 2, 297, 298, 7, 118, 2, 2, 298, 299, 7, 101, 2, 2, 299, 300, 7,
+	106, 2, 2, 300, 301, 7, 103, 2, 2, 301, 302, 7, 117, 2, 2, 302, 88, 3,
+	2, 2, 2, 303, 304, 7, 107, 2, 2, 304, 305, 7, 112, 2, 2, 305, 90, 3, 2,
+	2, 2, 306, 307, 7, 112, 2, 2, 307, 308, 7, 113, 2, 2, 308, 309, 7, 118,
+	2, 2, 309, 310, 7, 34, 2, 2, 310, 311, 7, 107, 2, 2, 311, 312, 7, 112,
+	2, 2, 312, 92, 3, 2, 2, 2, 313, 314, 7, 110, 2, 2, 314, 315, 7, 103, 2,
+	2, 315, 316, 7, 118, 2, 2, 316, 94, 3, 2, 2, 2, 317, 318, 7, 112, 2, 2,
+	318, 319, 7, 107, 2, 2, 319, 320, 7, 110, 2, 2, 320, 96, 3, 2, 2, 2, 321,
+	322, 7, 118, 2, 2, 322, 323, 7, 116, 2, 2, 323, 324, 7, 119, 2, 2, 324,
+	331, 7, 103, 2, 2, 325, 326, 7, 104, 2, 2, 326, 327, 7, 99, 2, 2, 327,
+	328, 7, 110, 2, 2, 328, 329, 7, 117, 2, 2, 329, 331, 7, 103, 2, 2, 330,
+	321, 3, 2, 2, 2, 330, 325, 3, 2, 2, 2, 331, 98, 3, 2, 2, 2, 332, 336, 5,
+	131, 66, 2, 333, 335, 5, 133, 67, 2, 334, 333, 3, 2, 2, 2, 335, 338, 3,
+	2, 2, 2, 336, 334, 3, 2, 2, 2, 336, 337, 3, 2, 2, 2, 337, 100, 3, 2, 2,
+	2, 338, 336, 3, 2, 2, 2, 339, 343, 5, 131, 66, 2, 340, 342, 5, 133, 67,
+	2, 341, 340, 3, 2, 2, 2, 342, 345, 3, 2, 2, 2, 343, 341, 3, 2, 2, 2, 343,
+	344, 3, 2, 2, 2, 344, 346, 3, 2, 2, 2, 345, 343, 3, 2, 2, 2, 346, 347,
+	7, 48, 2, 2, 347, 351, 5, 131, 66, 2, 348, 350, 5, 133, 67, 2, 349, 348,
+	3, 2, 2, 2, 350, 353, 3, 2, 2, 2, 351, 349, 3, 2, 2, 2, 351, 352, 3, 2,
+	2, 2, 352, 355, 3, 2, 2, 2, 353, 351, 3, 2, 2, 2, 354, 356, 5, 137, 69,
+	2, 355, 354, 3, 2, 2, 2, 355, 356, 3, 2, 2, 2, 356, 378, 3, 2, 2, 2, 357,
+	358, 7, 48, 2, 2, 358, 362, 5, 131, 66, 2, 359, 361, 5, 133, 67, 2, 360,
+	359, 3, 2, 2, 2, 361, 364, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 362, 363,
+	3, 2, 2, 2, 363, 366, 3, 2, 2, 2, 364, 362, 3, 2, 2, 2, 365, 367, 5, 137,
+	69, 2, 366, 365, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 378, 3, 2, 2, 2,
+	368, 372, 5, 131, 66, 2, 369, 371, 5, 133, 67, 2, 370, 369, 3, 2, 2, 2,
+	371, 374, 3, 2, 2, 2, 372, 370, 3, 2, 2, 2, 372, 373, 3, 2, 2, 2, 373,
+	375, 3, 2, 2, 2, 374, 372, 3, 2, 2, 2, 375, 376, 5, 137, 69, 2, 376, 378,
+	3, 2, 2, 2, 377, 339, 3, 2, 2, 2, 377, 357, 3, 2, 2, 2, 377, 368, 3, 2,
+	2, 2, 378, 102, 3, 2, 2, 2, 379, 380, 7, 50, 2, 2, 380, 384, 9, 2, 2, 2,
+	381, 383, 9, 3, 2, 2, 382, 381, 3, 2, 2, 2, 383, 386, 3, 2, 2, 2, 384,
+	382, 3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 104, 3, 2, 2, 2, 386, 384,
+	3, 2, 2, 2, 387, 388, 7, 50, 2, 2, 388, 392, 9, 4, 2, 2, 389, 391, 5, 133,
+	67, 2, 390, 389, 3, 2, 2, 2, 391, 394, 3, 2, 2, 2, 392, 390, 3, 2, 2, 2,
+	392, 393, 3, 2, 2, 2, 393, 106, 3, 2, 2, 2, 394, 392, 3, 2, 2, 2, 395,
+	396, 7, 50, 2, 2, 396, 400, 9, 5, 2, 2, 397, 399, 5, 133, 67, 2, 398, 397,
+	3, 2, 2, 2, 399, 402, 3, 2, 2, 2, 400, 398, 3, 2, 2, 2, 400, 401, 3, 2,
+	2, 2, 401, 108, 3, 2, 2, 2, 402, 400, 3, 2, 2, 2, 403, 405, 5, 131, 66,
+	2, 404, 403, 3, 2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 404, 3, 2, 2, 2, 406,
+	407, 3, 2, 2, 2, 407, 414, 3, 2, 2, 2, 408, 410, 7, 48, 2, 2, 409, 411,
+	5, 131, 66, 2, 410, 409, 3, 2, 2, 2, 411, 412, 3, 2, 2, 2, 412, 410, 3,
+	2, 2, 2, 412, 413, 3, 2, 2, 2, 413, 415, 3, 2, 2, 2, 414, 408, 3, 2, 2,
+	2, 414, 415, 3, 2, 2, 2, 415, 416, 3, 2, 2, 2, 416, 417, 5, 139, 70, 2,
+	417, 419, 3, 2, 2, 2, 418, 404, 3, 2, 2, 2, 419, 420, 3, 2, 2, 2, 420,
+	418, 3, 2, 2, 2, 420, 421, 3, 2, 2, 2, 421, 425, 3, 2, 2, 2, 422, 424,
+	5, 145, 73, 2, 423, 422, 3, 2, 2, 2, 424, 427, 3, 2, 2, 2, 425, 423, 3,
+	2, 2, 2, 425, 426, 3, 2, 2, 2, 426, 110, 3, 2, 2, 2, 427, 425, 3, 2, 2,
+	2, 428, 429, 5, 141, 71, 2, 429, 112, 3, 2, 2, 2, 430, 434, 7, 36, 2, 2,
+	431, 433, 5, 125, 63, 2, 432, 431, 3, 2, 2, 2, 433, 436, 3, 2, 2, 2, 434,
+	432, 3, 2, 2, 2, 434, 435, 3, 2, 2, 2, 435, 437, 3, 2, 2, 2, 436, 434,
+	3, 2, 2, 2, 437, 455, 7, 36, 2, 2, 438, 442, 7, 41, 2, 2, 439, 441, 5,
+	127, 64, 2, 440, 439, 3, 2, 2, 2, 441, 444, 3, 2, 2, 2, 442, 440, 3, 2,
+	2, 2, 442, 443, 3, 2, 2, 2, 443, 445, 3, 2, 2, 2, 444, 442, 3, 2, 2, 2,
+	445, 455, 7, 41, 2, 2, 446, 450, 7, 98, 2, 2, 447, 449, 10, 6, 2, 2, 448,
+	447, 3, 2, 2, 2, 449, 452, 3, 2, 2, 2, 450, 448, 3, 2, 2, 2, 450, 451,
+	3, 2, 2, 2, 451, 453, 3, 2, 2, 2, 452, 450, 3, 2, 2, 2, 453, 455, 7, 98,
+	2, 2, 454, 430, 3, 2, 2, 2, 454, 438, 3, 2, 2, 2, 454, 446, 3, 2, 2, 2,
+	455, 114, 3, 2, 2, 2, 456, 458, 9, 7, 2, 2, 457, 456, 3, 2, 2, 2, 458,
+	459, 3, 2, 2, 2, 459, 457, 3, 2, 2, 2, 459, 460, 3, 2, 2, 2, 460, 461,
+	3, 2, 2, 2, 461, 462, 8, 58, 2, 2, 462, 116, 3, 2, 2, 2, 463, 464, 7, 49,
+	2, 2, 464, 465, 7, 44, 2, 2, 465, 469, 3, 2, 2, 2, 466, 468, 11, 2, 2,
+	2, 467, 466, 3, 2, 2, 2, 468, 471, 3, 2, 2, 2, 469, 470, 3, 2, 2, 2, 469,
+	467, 3, 2, 2, 2, 470, 472, 3, 2, 2, 2, 471, 469, 3, 2, 2, 2, 472, 473,
+	7, 44, 2, 2, 473, 474, 7, 49, 2, 2, 474, 475, 3, 2, 2, 2, 475, 476, 8,
+	59, 2, 2, 476, 118, 3, 2, 2, 2, 477, 478, 7, 49, 2, 2, 478, 479, 7, 49,
+	2, 2, 479, 483, 3, 2, 2, 2, 480, 482, 10, 8, 2, 2, 481, 480, 3, 2, 2, 2,
+	482, 485, 3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 483, 484, 3, 2, 2, 2, 484,
+	486, 3, 2, 2, 2, 485, 483, 3, 2, 2, 2, 486, 487, 8, 60, 2, 2, 487, 120,
+	3, 2, 2, 2, 488, 489, 9, 8, 2, 2, 489, 490, 3, 2, 2, 2, 490, 491, 8, 61,
+	2, 2, 491, 122, 3, 2, 2, 2, 492, 493, 11, 2, 2, 2, 493, 124, 3, 2, 2, 2,
+	494, 502, 10, 9, 2, 2, 495, 499, 7, 94, 2, 2, 496, 497, 7, 15, 2, 2, 497,
+	500, 7, 12, 2, 2, 498, 500, 11, 2, 2, 2, 499, 496, 3, 2, 2, 2, 499, 498,
+	3, 2, 2, 2, 500, 502, 3, 2, 2, 2, 501, 494, 3, 2, 2, 2, 501, 495, 3, 2,
+	2, 2, 502, 126, 3, 2, 2, 2, 503, 511, 10, 10, 2, 2, 504, 508, 7, 94, 2,
+	2, 505, 506, 7, 15, 2, 2, 506, 509, 7, 12, 2, 2, 507, 509, 11, 2, 2, 2,
+	508, 505, 3, 2, 2, 2, 508, 507, 3, 2, 2, 2, 509, 511, 3, 2, 2, 2, 510,
+	503, 3, 2, 2, 2, 510, 504, 3, 2, 2, 2, 511, 128, 3, 2, 2, 2, 512, 513,
+	7, 119, 2, 2, 513, 514, 5, 135, 68, 2, 514, 515, 5, 135, 68, 2, 515, 516,
+	5, 135, 68, 2, 516, 517, 5, 135, 68, 2, 517, 130, 3, 2, 2, 2, 518, 519,
+	9, 11, 2, 2, 519, 132, 3, 2, 2, 2, 520, 521, 9, 12, 2, 2, 521, 134, 3,
+	2, 2, 2, 522, 523, 9, 13, 2, 2, 523, 136, 3, 2, 2, 2, 524, 526, 9, 14,
+	2, 2, 525, 527, 9, 15, 2, 2, 526, 525, 3, 2, 2, 2, 526, 527, 3, 2, 2, 2,
+	527, 528, 3, 2, 2, 2, 528, 532, 5, 131, 66, 2, 529, 531, 5, 133, 67, 2,
+	530, 529, 3, 2, 2, 2, 531, 534, 3, 2, 2, 2, 532, 530, 3, 2, 2, 2, 532,
+	533, 3, 2, 2, 2, 533, 138, 3, 2, 2, 2, 534, 532, 3, 2, 2, 2, 535, 536,
+	7, 112, 2, 2, 536, 545, 7, 117, 2, 2, 537, 538, 7, 119, 2, 2, 538, 545,
+	7, 117, 2, 2, 539, 540, 7, 183, 2, 2, 540, 545, 7, 117, 2, 2, 541, 542,
+	7, 111, 2, 2, 542, 545, 7, 117, 2, 2, 543, 545, 9, 16, 2, 2, 544, 535,
+	3, 2, 2, 2, 544, 537, 3, 2, 2, 2, 544, 539, 3, 2, 2, 2, 544, 541, 3, 2,
+	2, 2, 544, 543, 3, 2, 2, 2, 545, 140, 3, 2, 2, 2, 546, 550, 5, 143, 72,
+	2, 547, 549, 5, 145, 73, 2, 548, 547, 3, 2, 2, 2, 549, 552, 3, 2, 2, 2,
+	550, 548, 3, 2, 2, 2, 550, 551, 3, 2, 2, 2, 551, 142, 3, 2, 2, 2, 552,
+	550, 3, 2, 2, 2, 553, 558, 5, 147, 74, 2, 554, 558, 9, 17, 2, 2, 555, 556,
+	7, 94, 2, 2, 556, 558, 5, 129, 65, 2, 557, 553, 3, 2, 2, 2, 557, 554, 3,
+	2, 2, 2, 557, 555, 3, 2, 2, 2, 558, 144, 3, 2, 2, 2, 559, 566, 5, 143,
+	72, 2, 560, 566, 5, 149, 75, 2, 561, 566, 5, 151, 76, 2, 562, 566, 5, 153,
+	77, 2, 563, 566, 5, 155, 78, 2, 564, 566, 5, 157, 79, 2, 565, 559, 3, 2,
+	2, 2, 565, 560, 3, 2, 2, 2, 565, 561, 3, 2, 2, 2, 565, 562, 3, 2, 2, 2,
+	565, 563, 3, 2, 2, 2, 565, 564, 3, 2, 2, 2, 566, 146, 3, 2, 2, 2, 567,
+	569, 9, 18, 2, 2, 568, 567, 3, 2, 2, 2, 569, 148, 3, 2, 2, 2, 570, 572,
+	9, 19, 2, 2, 571, 570, 3, 2, 2, 2, 572, 150, 3, 2, 2, 2, 573, 575, 9, 20,
+	2, 2, 574, 573, 3, 2, 2, 2, 575, 152, 3, 2, 2, 2, 576, 578, 9, 21, 2, 2,
+	577, 576, 3, 2, 2, 2, 578, 154, 3, 2, 2, 2, 579, 580, 7, 8206, 2, 2, 580,
+	156, 3, 2, 2, 2, 581, 582, 7, 8207, 2, 2, 582, 158, 3, 2, 2, 2, 45, 2,
+	205, 251, 258, 264, 330, 336, 343, 351, 355, 362, 366, 372, 377, 384, 392,
+	400, 406, 412, 414, 420, 425, 434, 442, 450, 454, 459, 469, 483, 499, 501,
+	508, 510, 526, 532, 544, 550, 557, 565, 568, 571, 574, 577, 3, 2, 3, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -342,41 +360,43 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'..'", "'len'", "'all'", "'none'", "'any'", "'one'", "'filter'", "'map'",
-	"'['", "']'", "'('", "')'", "'{'", "'}'", "';'", "','", "'='", "'?'", "':'",
-	"'.'", "'+'", "'-'", "", "'*'", "'**'", "'/'", "'%'", "'>>'", "'<<'", "'<'",
-	"'>'", "'<='", "'>='", "'=='", "'!='", "'#'", "", "", "", "'startsWith'",
-	"'endsWith'", "'contains'", "'matches'", "'in'", "'not in'", "'nil'",
+	"", "'['", "']'", "'('", "')'", "'{'", "'}'", "';'", "','", "'='", "'=>'",
+	"'?'", "'?.'", "'??'", "':'", "'.'", "'..'", "'+'", "'-'", "", "'~'", "'*'",
+	"'**'", "'/'", "'%'", "'&'", "'|'", "'^'", "'&^'", "'>>'", "'<<'", "'<'",
+	"'>'", "'<='", "'>='", "'=='", "'!='", "", "", "", "'startsWith'", "'endsWith'",
+	"'contains'", "'matches'", "'in'", "'not in'", "'let'", "'nil'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "OpenBracket", "CloseBracket", "OpenParen",
-	"CloseParen", "OpenBrace", "CloseBrace", "SemiColon", "Comma", "Assign",
-	"QuestionMark", "Colon", "Dot", "Plus", "Minus", "Not", "Multiply", "Exponent",
-	"Divide", "Modulus", "RightShiftArithmetic", "LeftShiftArithmetic", "LessThan",
-	"MoreThan", "LessThanEquals", "GreaterThanEquals", "Equals", "NotEquals",
-	"Pointer", "And", "Or", "Builtins", "StartsWith", "EndsWith", "Contains",
-	"Matches", "In", "NotIn", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
-	"FloatLiteral", "HexIntegerLiteral", "Identifier", "StringLiteral", "WhiteSpaces",
-	"MultiLineComment", "SingleLineComment", "LineTerminator", "UnexpectedCharacter",
+	"", "OpenBracket", "CloseBracket", "OpenParen", "CloseParen", "OpenBrace",
+	"CloseBrace", "SemiColon", "Comma", "Assign", "Arrow", "QuestionMark",
+	"QuestionDot", "NilCoalescing", "Colon", "Dot", "Range", "Plus", "Minus",
+	"Not", "BitNot", "Multiply", "Exponent", "Divide", "Modulus", "BitAnd",
+	"BitOr", "BitXor", "BitClear", "RightShiftArithmetic", "LeftShiftArithmetic",
+	"LessThan", "MoreThan", "LessThanEquals", "GreaterThanEquals", "Equals",
+	"NotEquals", "Pointer", "And", "Or", "StartsWith", "EndsWith", "Contains",
+	"Matches", "In", "NotIn", "Let", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
+	"FloatLiteral", "HexIntegerLiteral", "OctalIntegerLiteral", "BinaryIntegerLiteral",
+	"DurationLiteral", "Identifier", "StringLiteral", "WhiteSpaces", "MultiLineComment",
+	"SingleLineComment", "LineTerminator", "UnexpectedCharacter",
 }
 
 var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "OpenBracket",
-	"CloseBracket", "OpenParen", "CloseParen", "OpenBrace", "CloseBrace", "SemiColon",
-	"Comma", "Assign", "QuestionMark", "Colon", "Dot", "Plus", "Minus", "Not",
-	"Multiply", "Exponent", "Divide", "Modulus", "RightShiftArithmetic", "LeftShiftArithmetic",
+	"OpenBracket", "CloseBracket", "OpenParen", "CloseParen", "OpenBrace",
+	"CloseBrace", "SemiColon", "Comma", "Assign", "Arrow", "QuestionMark",
+	"QuestionDot", "NilCoalescing", "Colon", "Dot", "Range", "Plus", "Minus",
+	"Not", "BitNot", "Multiply", "Exponent", "Divide", "Modulus", "BitAnd",
+	"BitOr", "BitXor", "BitClear", "RightShiftArithmetic", "LeftShiftArithmetic",
 	"LessThan", "MoreThan", "LessThanEquals", "GreaterThanEquals", "Equals",
-	"NotEquals", "Pointer", "And", "Or", "Builtins", "StartsWith", "EndsWith",
-	"Contains", "Matches", "In", "NotIn", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
-	"FloatLiteral", "HexIntegerLiteral", "Identifier", "StringLiteral", "WhiteSpaces",
-	"MultiLineComment", "SingleLineComment", "LineTerminator", "UnexpectedCharacter",
-	"DoubleStringCharacter", "SingleStringCharacter", "EscapeSequence", "CharacterEscapeSequence",
-	"HexEscapeSequence", "UnicodeEscapeSequence", "SingleEscapeCharacter",
-	"NonEscapeCharacter", "EscapeCharacter", "LineContinuation", "LineTerminatorSequence",
-	"DecimalDigit", "HexDigit", "DecimalLiteral", "IdentifierStart", "IdentifierPart",
-	"UnicodeLetter", "UnicodeCombiningMark", "UnicodeDigit", "UnicodeConnectorPunctuation",
-	"ZWNJ", "ZWJ",
+	"NotEquals", "Pointer", "And", "Or", "StartsWith", "EndsWith", "Contains",
+	"Matches", "In", "NotIn", "Let", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
+	"FloatLiteral", "HexIntegerLiteral", "OctalIntegerLiteral", "BinaryIntegerLiteral",
+	"DurationLiteral", "Identifier", "StringLiteral", "WhiteSpaces", "MultiLineComment",
+	"SingleLineComment", "LineTerminator", "UnexpectedCharacter", "DoubleStringCharacter",
+	"SingleStringCharacter", "UnicodeEscapeSequence", "DecimalDigit", "DecimalDigitOrSeparator",
+	"HexDigit", "ExponentPart", "DurationUnit", "IdentifierName", "IdentifierStart",
+	"IdentifierPart", "UnicodeLetter", "UnicodeCombiningMark", "UnicodeDigit",
+	"UnicodeConnectorPunctuation", "ZWNJ", "ZWJ",
 }
 
 type ExprLexer struct {
@@ -414,61 +434,85 @@ func NewExprLexer(input antlr.CharStream) *ExprLexer {
 
 // ExprLexer tokens.
 const (
-	ExprLexerT__0                 = 1
-	ExprLexerT__1                 = 2
-	ExprLexerT__2                 = 3
-	ExprLexerT__3                 = 4
-	ExprLexerT__4                 = 5
-	ExprLexerT__5                 = 6
-	ExprLexerT__6                 = 7
-	ExprLexerT__7                 = 8
-	ExprLexerOpenBracket          = 9
-	ExprLexerCloseBracket         = 10
-	ExprLexerOpenParen            = 11
-	ExprLexerCloseParen           = 12
-	ExprLexerOpenBrace            = 13
-	ExprLexerCloseBrace           = 14
-	ExprLexerSemiColon            = 15
-	ExprLexerComma                = 16
-	ExprLexerAssign               = 17
-	ExprLexerQuestionMark         = 18
-	ExprLexerColon                = 19
-	ExprLexerDot                  = 20
-	ExprLexerPlus                 = 21
-	ExprLexerMinus                = 22
-	ExprLexerNot                  = 23
-	ExprLexerMultiply             = 24
-	ExprLexerExponent             = 25
-	ExprLexerDivide               = 26
-	ExprLexerModulus              = 27
-	ExprLexerRightShiftArithmetic = 28
-	ExprLexerLeftShiftArithmetic  = 29
-	ExprLexerLessThan             = 30
-	ExprLexerMoreThan             = 31
-	ExprLexerLessThanEquals       = 32
-	ExprLexerGreaterThanEquals    = 33
-	ExprLexerEquals               = 34
-	ExprLexerNotEquals            = 35
-	ExprLexerPointer              = 36
-	ExprLexerAnd                  = 37
-	ExprLexerOr                   = 38
-	ExprLexerBuiltins             = 39
+	ExprLexerOpenBracket          = 1
+	ExprLexerCloseBracket         = 2
+	ExprLexerOpenParen            = 3
+	ExprLexerCloseParen           = 4
+	ExprLexerOpenBrace            = 5
+	ExprLexerCloseBrace           = 6
+	ExprLexerSemiColon            = 7
+	ExprLexerComma                = 8
+	ExprLexerAssign               = 9
+	ExprLexerArrow                = 10
+	ExprLexerQuestionMark         = 11
+	ExprLexerQuestionDot          = 12
+	ExprLexerNilCoalescing        = 13
+	ExprLexerColon                = 14
+	ExprLexerDot                  = 15
+	ExprLexerRange                = 16
+	ExprLexerPlus                 = 17
+	ExprLexerMinus                = 18
+	ExprLexerNot                  = 19
+	ExprLexerBitNot               = 20
+	ExprLexerMultiply             = 21
+	ExprLexerExponent             = 22
+	ExprLexerDivide               = 23
+	ExprLexerModulus              = 24
+	ExprLexerBitAnd               = 25
+	ExprLexerBitOr                = 26
+	ExprLexerBitXor               = 27
+	ExprLexerBitClear             = 28
+	ExprLexerRightShiftArithmetic = 29
+	ExprLexerLeftShiftArithmetic  = 30
+	ExprLexerLessThan             = 31
+	ExprLexerMoreThan             = 32
+	ExprLexerLessThanEquals       = 33
+	ExprLexerGreaterThanEquals    = 34
+	ExprLexerEquals               = 35
+	ExprLexerNotEquals            = 36
+	ExprLexerPointer              = 37
+	ExprLexerAnd                  = 38
+	ExprLexerOr                   = 39
 	ExprLexerStartsWith           = 40
 	ExprLexerEndsWith             = 41
 	ExprLexerContains             = 42
 	ExprLexerMatches              = 43
 	ExprLexerIn                   = 44
 	ExprLexerNotIn                = 45
-	ExprLexerNilLiteral           = 46
-	ExprLexerBooleanLiteral       = 47
-	ExprLexerIntegerLiteral       = 48
-	ExprLexerFloatLiteral         = 49
-	ExprLexerHexIntegerLiteral    = 50
-	ExprLexerIdentifier           = 51
-	ExprLexerStringLiteral        = 52
-	ExprLexerWhiteSpaces          = 53
-	ExprLexerMultiLineComment     = 54
-	ExprLexerSingleLineComment    = 55
-	ExprLexerLineTerminator       = 56
-	ExprLexerUnexpectedCharacter  = 57
+	ExprLexerLet                  = 46
+	ExprLexerNilLiteral           = 47
+	ExprLexerBooleanLiteral       = 48
+	ExprLexerIntegerLiteral       = 49
+	ExprLexerFloatLiteral         = 50
+	ExprLexerHexIntegerLiteral    = 51
+	ExprLexerOctalIntegerLiteral  = 52
+	ExprLexerBinaryIntegerLiteral = 53
+	ExprLexerDurationLiteral      = 54
+	ExprLexerIdentifier           = 55
+	ExprLexerStringLiteral        = 56
+	ExprLexerWhiteSpaces          = 57
+	ExprLexerMultiLineComment     = 58
+	ExprLexerSingleLineComment    = 59
+	ExprLexerLineTerminator       = 60
+	ExprLexerUnexpectedCharacter  = 61
 )
+
+func (l *ExprLexer) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 11:
+		return l.QuestionDot_Sempred(localctx, predIndex)
+
+	default:
+		panic("No registered predicate for: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (p *ExprLexer) QuestionDot_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 0:
+		return p.GetInputStream().LA(1) < '0' || p.GetInputStream().LA(1) > '9'
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
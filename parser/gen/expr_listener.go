@@ -10,6 +10,42 @@ type ExprListener interface {
 	// EnterStart is called when entering the start production.
 	EnterStart(c *StartContext)
 
+	// EnterTernaryExpression is called when entering the TernaryExpression production.
+	EnterTernaryExpression(c *TernaryExpressionContext)
+
+	// EnterNilCoalescingExpression is called when entering the NilCoalescingExpression production.
+	EnterNilCoalescingExpression(c *NilCoalescingExpressionContext)
+
+	// EnterInExpression is called when entering the InExpression production.
+	EnterInExpression(c *InExpressionContext)
+
+	// EnterUnaryExpression is called when entering the UnaryExpression production.
+	EnterUnaryExpression(c *UnaryExpressionContext)
+
+	// EnterRangeExpression is called when entering the RangeExpression production.
+	EnterRangeExpression(c *RangeExpressionContext)
+
+	// EnterLogicalExpression is called when entering the LogicalExpression production.
+	EnterLogicalExpression(c *LogicalExpressionContext)
+
+	// EnterLetExpression is called when entering the LetExpression production.
+	EnterLetExpression(c *LetExpressionContext)
+
+	// EnterEndsWithExpression is called when entering the EndsWithExpression production.
+	EnterEndsWithExpression(c *EndsWithExpressionContext)
+
+	// EnterStartsWithExpression is called when entering the StartsWithExpression production.
+	EnterStartsWithExpression(c *StartsWithExpressionContext)
+
+	// EnterEqualityExpression is called when entering the EqualityExpression production.
+	EnterEqualityExpression(c *EqualityExpressionContext)
+
+	// EnterMultiplicativeExpression is called when entering the MultiplicativeExpression production.
+	EnterMultiplicativeExpression(c *MultiplicativeExpressionContext)
+
+	// EnterCallExpression is called when entering the CallExpression production.
+	EnterCallExpression(c *CallExpressionContext)
+
 	// EnterParenthesizedExpression is called when entering the ParenthesizedExpression production.
 	EnterParenthesizedExpression(c *ParenthesizedExpressionContext)
 
@@ -19,9 +55,6 @@ type ExprListener interface {
 	// EnterRelationalExpression is called when entering the RelationalExpression production.
 	EnterRelationalExpression(c *RelationalExpressionContext)
 
-	// EnterTernaryExpression is called when entering the TernaryExpression production.
-	EnterTernaryExpression(c *TernaryExpressionContext)
-
 	// EnterContainsExpression is called when entering the ContainsExpression production.
 	EnterContainsExpression(c *ContainsExpressionContext)
 
@@ -34,21 +67,12 @@ type ExprListener interface {
 	// EnterLiteralExpression is called when entering the LiteralExpression production.
 	EnterLiteralExpression(c *LiteralExpressionContext)
 
-	// EnterInExpression is called when entering the InExpression production.
-	EnterInExpression(c *InExpressionContext)
-
 	// EnterArrayLiteralExpression is called when entering the ArrayLiteralExpression production.
 	EnterArrayLiteralExpression(c *ArrayLiteralExpressionContext)
 
 	// EnterMemberDotExpression is called when entering the MemberDotExpression production.
 	EnterMemberDotExpression(c *MemberDotExpressionContext)
 
-	// EnterUnaryExpression is called when entering the UnaryExpression production.
-	EnterUnaryExpression(c *UnaryExpressionContext)
-
-	// EnterRangeExpression is called when entering the RangeExpression production.
-	EnterRangeExpression(c *RangeExpressionContext)
-
 	// EnterMemberIndexExpression is called when entering the MemberIndexExpression production.
 	EnterMemberIndexExpression(c *MemberIndexExpressionContext)
 
@@ -58,42 +82,18 @@ type ExprListener interface {
 	// EnterPointerExpression is called when entering the PointerExpression production.
 	EnterPointerExpression(c *PointerExpressionContext)
 
-	// EnterLogicalExpression is called when entering the LogicalExpression production.
-	EnterLogicalExpression(c *LogicalExpressionContext)
-
 	// EnterClosureMemberDotExpression is called when entering the ClosureMemberDotExpression production.
 	EnterClosureMemberDotExpression(c *ClosureMemberDotExpressionContext)
 
-	// EnterEndsWithExpression is called when entering the EndsWithExpression production.
-	EnterEndsWithExpression(c *EndsWithExpressionContext)
-
-	// EnterStartsWithExpression is called when entering the StartsWithExpression production.
-	EnterStartsWithExpression(c *StartsWithExpressionContext)
-
-	// EnterEqualityExpression is called when entering the EqualityExpression production.
-	EnterEqualityExpression(c *EqualityExpressionContext)
-
-	// EnterBuiltinLiteralExpression is called when entering the BuiltinLiteralExpression production.
-	EnterBuiltinLiteralExpression(c *BuiltinLiteralExpressionContext)
-
-	// EnterMultiplicativeExpression is called when entering the MultiplicativeExpression production.
-	EnterMultiplicativeExpression(c *MultiplicativeExpressionContext)
-
-	// EnterCallExpression is called when entering the CallExpression production.
-	EnterCallExpression(c *CallExpressionContext)
-
-	// EnterLenBuiltinExpression is called when entering the LenBuiltinExpression production.
-	EnterLenBuiltinExpression(c *LenBuiltinExpressionContext)
+	// EnterArguments is called when entering the arguments production.
+	EnterArguments(c *ArgumentsContext)
 
-	// EnterBuiltinExpression is called when entering the BuiltinExpression production.
-	EnterBuiltinExpression(c *BuiltinExpressionContext)
+	// EnterArgument is called when entering the argument production.
+	EnterArgument(c *ArgumentContext)
 
 	// EnterClosureExpression is called when entering the ClosureExpression production.
 	EnterClosureExpression(c *ClosureExpressionContext)
 
-	// EnterArguments is called when entering the arguments production.
-	EnterArguments(c *ArgumentsContext)
-
 	// EnterArrayLiteral is called when entering the arrayLiteral production.
 	EnterArrayLiteral(c *ArrayLiteralContext)
 
@@ -124,6 +124,9 @@ type ExprListener interface {
 	// EnterFloatExpression is called when entering the FloatExpression production.
 	EnterFloatExpression(c *FloatExpressionContext)
 
+	// EnterDurationExpression is called when entering the DurationExpression production.
+	EnterDurationExpression(c *DurationExpressionContext)
+
 	// EnterStringLiteral is called when entering the stringLiteral production.
 	EnterStringLiteral(c *StringLiteralContext)
 
@@ -133,6 +136,42 @@ type ExprListener interface {
 	// ExitStart is called when exiting the start production.
 	ExitStart(c *StartContext)
 
+	// ExitTernaryExpression is called when exiting the TernaryExpression production.
+	ExitTernaryExpression(c *TernaryExpressionContext)
+
+	// ExitNilCoalescingExpression is called when exiting the NilCoalescingExpression production.
+	ExitNilCoalescingExpression(c *NilCoalescingExpressionContext)
+
+	// ExitInExpression is called when exiting the InExpression production.
+	ExitInExpression(c *InExpressionContext)
+
+	// ExitUnaryExpression is called when exiting the UnaryExpression production.
+	ExitUnaryExpression(c *UnaryExpressionContext)
+
+	// ExitRangeExpression is called when exiting the RangeExpression production.
+	ExitRangeExpression(c *RangeExpressionContext)
+
+	// ExitLogicalExpression is called when exiting the LogicalExpression production.
+	ExitLogicalExpression(c *LogicalExpressionContext)
+
+	// ExitLetExpression is called when exiting the LetExpression production.
+	ExitLetExpression(c *LetExpressionContext)
+
+	// ExitEndsWithExpression is called when exiting the EndsWithExpression production.
+	ExitEndsWithExpression(c *EndsWithExpressionContext)
+
+	// ExitStartsWithExpression is called when exiting the StartsWithExpression production.
+	ExitStartsWithExpression(c *StartsWithExpressionContext)
+
+	// ExitEqualityExpression is called when exiting the EqualityExpression production.
+	ExitEqualityExpression(c *EqualityExpressionContext)
+
+	// ExitMultiplicativeExpression is called when exiting the MultiplicativeExpression production.
+	ExitMultiplicativeExpression(c *MultiplicativeExpressionContext)
+
+	// ExitCallExpression is called when exiting the CallExpression production.
+	ExitCallExpression(c *CallExpressionContext)
+
 	// ExitParenthesizedExpression is called when exiting the ParenthesizedExpression production.
 	ExitParenthesizedExpression(c *ParenthesizedExpressionContext)
 
@@ -142,9 +181,6 @@ type ExprListener interface {
 	// ExitRelationalExpression is called when exiting the RelationalExpression production.
 	ExitRelationalExpression(c *RelationalExpressionContext)
 
-	// ExitTernaryExpression is called when exiting the TernaryExpression production.
-	ExitTernaryExpression(c *TernaryExpressionContext)
-
 	// ExitContainsExpression is called when exiting the ContainsExpression production.
 	ExitContainsExpression(c *ContainsExpressionContext)
 
@@ -157,21 +193,12 @@ type ExprListener interface {
 	// ExitLiteralExpression is called when exiting the LiteralExpression production.
 	ExitLiteralExpression(c *LiteralExpressionContext)
 
-	// ExitInExpression is called when exiting the InExpression production.
-	ExitInExpression(c *InExpressionContext)
-
 	// ExitArrayLiteralExpression is called when exiting the ArrayLiteralExpression production.
 	ExitArrayLiteralExpression(c *ArrayLiteralExpressionContext)
 
 	// ExitMemberDotExpression is called when exiting the MemberDotExpression production.
 	ExitMemberDotExpression(c *MemberDotExpressionContext)
 
-	// ExitUnaryExpression is called when exiting the UnaryExpression production.
-	ExitUnaryExpression(c *UnaryExpressionContext)
-
-	// ExitRangeExpression is called when exiting the RangeExpression production.
-	ExitRangeExpression(c *RangeExpressionContext)
-
 	// ExitMemberIndexExpression is called when exiting the MemberIndexExpression production.
 	ExitMemberIndexExpression(c *MemberIndexExpressionContext)
 
@@ -181,42 +208,18 @@ type ExprListener interface {
 	// ExitPointerExpression is called when exiting the PointerExpression production.
 	ExitPointerExpression(c *PointerExpressionContext)
 
-	// ExitLogicalExpression is called when exiting the LogicalExpression production.
-	ExitLogicalExpression(c *LogicalExpressionContext)
-
 	// ExitClosureMemberDotExpression is called when exiting the ClosureMemberDotExpression production.
 	ExitClosureMemberDotExpression(c *ClosureMemberDotExpressionContext)
 
-	// ExitEndsWithExpression is called when exiting the EndsWithExpression production.
-	ExitEndsWithExpression(c *EndsWithExpressionContext)
-
-	// ExitStartsWithExpression is called when exiting the StartsWithExpression production.
-	ExitStartsWithExpression(c *StartsWithExpressionContext)
-
-	// ExitEqualityExpression is called when exiting the EqualityExpression production.
-	ExitEqualityExpression(c *EqualityExpressionContext)
-
-	// ExitBuiltinLiteralExpression is called when exiting the BuiltinLiteralExpression production.
-	ExitBuiltinLiteralExpression(c *BuiltinLiteralExpressionContext)
-
-	// ExitMultiplicativeExpression is called when exiting the MultiplicativeExpression production.
-	ExitMultiplicativeExpression(c *MultiplicativeExpressionContext)
-
-	// ExitCallExpression is called when exiting the CallExpression production.
-	ExitCallExpression(c *CallExpressionContext)
-
-	// ExitLenBuiltinExpression is called when exiting the LenBuiltinExpression production.
-	ExitLenBuiltinExpression(c *LenBuiltinExpressionContext)
+	// ExitArguments is called when exiting the arguments production.
+	ExitArguments(c *ArgumentsContext)
 
-	// ExitBuiltinExpression is called when exiting the BuiltinExpression production.
-	ExitBuiltinExpression(c *BuiltinExpressionContext)
+	// ExitArgument is called when exiting the argument production.
+	ExitArgument(c *ArgumentContext)
 
 	// ExitClosureExpression is called when exiting the ClosureExpression production.
 	ExitClosureExpression(c *ClosureExpressionContext)
 
-	// ExitArguments is called when exiting the arguments production.
-	ExitArguments(c *ArgumentsContext)
-
 	// ExitArrayLiteral is called when exiting the arrayLiteral production.
 	ExitArrayLiteral(c *ArrayLiteralContext)
 
@@ -247,6 +250,9 @@ type ExprListener interface {
 	// ExitFloatExpression is called when exiting the FloatExpression production.
 	ExitFloatExpression(c *FloatExpressionContext)
 
+	// ExitDurationExpression is called when exiting the DurationExpression production.
+	ExitDurationExpression(c *DurationExpressionContext)
+
 	// ExitStringLiteral is called when exiting the stringLiteral production.
 	ExitStringLiteral(c *StringLiteralContext)
 
@@ -15,132 +15,126 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 59, 225,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 63, 204,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 47, 10,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 5, 3, 53, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 102, 10, 3, 3, 3, 7, 3, 105, 10,
-	3, 12, 3, 14, 3, 108, 11, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 157, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6,
-	3, 6, 3, 6, 7, 6, 166, 10, 6, 12, 6, 14, 6, 169, 11, 6, 3, 7, 3, 7, 3,
-	7, 3, 7, 3, 7, 3, 7, 7, 7, 177, 10, 7, 12, 7, 14, 7, 180, 11, 7, 3, 7,
-	5, 7, 183, 10, 7, 3, 7, 3, 7, 5, 7, 187, 10, 7, 3, 8, 3, 8, 3, 8, 3, 8,
-	3, 8, 5, 8, 194, 10, 8, 3, 8, 3, 8, 5, 8, 198, 10, 8, 3, 9, 3, 9, 3, 9,
-	7, 9, 203, 10, 9, 12, 9, 14, 9, 206, 11, 9, 3, 10, 3, 10, 3, 10, 3, 10,
-	3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 219, 10, 12, 3,
-	13, 3, 13, 3, 14, 3, 14, 3, 14, 2, 3, 4, 15, 2, 4, 6, 8, 10, 12, 14, 16,
-	18, 20, 22, 24, 26, 2, 10, 3, 2, 23, 25, 3, 2, 26, 29, 3, 2, 23, 24, 3,
-	2, 32, 35, 3, 2, 46, 47, 3, 2, 36, 37, 3, 2, 53, 54, 4, 2, 50, 50, 52,
-	52, 2, 253, 2, 28, 3, 2, 2, 2, 4, 46, 3, 2, 2, 2, 6, 156, 3, 2, 2, 2, 8,
-	158, 3, 2, 2, 2, 10, 162, 3, 2, 2, 2, 12, 186, 3, 2, 2, 2, 14, 197, 3,
-	2, 2, 2, 16, 199, 3, 2, 2, 2, 18, 207, 3, 2, 2, 2, 20, 211, 3, 2, 2, 2,
-	22, 218, 3, 2, 2, 2, 24, 220, 3, 2, 2, 2, 26, 222, 3, 2, 2, 2, 28, 29,
-	5, 4, 3, 2, 29, 30, 7, 2, 2, 3, 30, 3, 3, 2, 2, 2, 31, 32, 8, 3, 1, 2,
-	32, 33, 7, 22, 2, 2, 33, 47, 7, 53, 2, 2, 34, 47, 5, 6, 4, 2, 35, 36, 9,
-	2, 2, 2, 36, 47, 5, 4, 3, 22, 37, 47, 7, 53, 2, 2, 38, 47, 7, 38, 2, 2,
-	39, 47, 5, 22, 12, 2, 40, 47, 5, 12, 7, 2, 41, 47, 5, 14, 8, 2, 42, 43,
-	7, 13, 2, 2, 43, 44, 5, 4, 3, 2, 44, 45, 7, 14, 2, 2, 45, 47, 3, 2, 2,
-	2, 46, 31, 3, 2, 2, 2, 46, 34, 3, 2, 2, 2, 46, 35, 3, 2, 2, 2, 46, 37,
-	3, 2, 2, 2, 46, 38, 3, 2, 2, 2, 46, 39, 3, 2, 2, 2, 46, 40, 3, 2, 2, 2,
-	46, 41, 3, 2, 2, 2, 46, 42, 3, 2, 2, 2, 47, 106, 3, 2, 2, 2, 48, 49, 12,
-	21, 2, 2, 49, 50, 7, 3, 2, 2, 50, 105, 5, 4, 3, 22, 51, 52, 12, 20, 2,
-	2, 52, 53, 9, 3, 2, 2, 53, 105, 5, 4, 3, 21, 54, 55, 12, 19, 2, 2, 55,
-	56, 9, 4, 2, 2, 56, 105, 5, 4, 3, 20, 57, 58, 12, 18, 2, 2, 58, 59, 9,
-	5, 2, 2, 59, 105, 5, 4, 3, 19, 60, 61, 12, 17, 2, 2, 61, 62, 7, 42, 2,
-	2, 62, 105, 5, 4, 3, 18, 63, 64, 12, 16, 2, 2, 64, 65, 7, 43, 2, 2, 65,
-	105, 5, 4, 3, 17, 66, 67, 12, 15, 2, 2, 67, 68, 7, 44, 2, 2, 68, 105, 5,
-	4, 3, 16, 69, 70, 12, 14, 2, 2, 70, 71, 7, 45, 2, 2, 71, 105, 5, 4, 3,
-	15, 72, 73, 12, 13, 2, 2, 73, 74, 9, 6, 2, 2, 74, 105, 5, 4, 3, 14, 75,
-	76, 12, 12, 2, 2, 76, 77, 9, 7, 2, 2, 77, 105, 5, 4, 3, 13, 78, 79, 12,
-	11, 2, 2, 79, 80, 7, 39, 2, 2, 80, 105, 5, 4, 3, 12, 81, 82, 12, 10, 2,
-	2, 82, 83, 7, 40, 2, 2, 83, 105, 5, 4, 3, 11, 84, 85, 12, 9, 2, 2, 85,
-	86, 7, 20, 2, 2, 86, 87, 5, 4, 3, 2, 87, 88, 7, 21, 2, 2, 88, 89, 5, 4,
-	3, 10, 89, 105, 3, 2, 2, 2, 90, 91, 12, 26, 2, 2, 91, 92, 7, 11, 2, 2,
-	92, 93, 5, 4, 3, 2, 93, 94, 7, 12, 2, 2, 94, 105, 3, 2, 2, 2, 95, 96, 12,
-	25, 2, 2, 96, 97, 7, 22, 2, 2, 97, 105, 7, 53, 2, 2, 98, 99, 12, 23, 2,
-	2, 99, 101, 7, 13, 2, 2, 100, 102, 5, 10, 6, 2, 101, 100, 3, 2, 2, 2, 101,
-	102, 3, 2, 2, 2, 102, 103, 3, 2, 2, 2, 103, 105, 7, 14, 2, 2, 104, 48,
-	3, 2, 2, 2, 104, 51, 3, 2, 2, 2, 104, 54, 3, 2, 2, 2, 104, 57, 3, 2, 2,
-	2, 104, 60, 3, 2, 2, 2, 104, 63, 3, 2, 2, 2, 104, 66, 3, 2, 2, 2, 104,
-	69, 3, 2, 2, 2, 104, 72, 3, 2, 2, 2, 104, 75, 3, 2, 2, 2, 104, 78, 3, 2,
-	2, 2, 104, 81, 3, 2, 2, 2, 104, 84, 3, 2, 2, 2, 104, 90, 3, 2, 2, 2, 104,
-	95, 3, 2, 2, 2, 104, 98, 3, 2, 2, 2, 105, 108, 3, 2, 2, 2, 106, 104, 3,
-	2, 2, 2, 106, 107, 3, 2, 2, 2, 107, 5, 3, 2, 2, 2, 108, 106, 3, 2, 2, 2,
-	109, 110, 7, 4, 2, 2, 110, 111, 7, 13, 2, 2, 111, 112, 5, 4, 3, 2, 112,
-	113, 7, 14, 2, 2, 113, 157, 3, 2, 2, 2, 114, 115, 7, 5, 2, 2, 115, 116,
-	7, 13, 2, 2, 116, 117, 5, 4, 3, 2, 117, 118, 7, 18, 2, 2, 118, 119, 5,
-	8, 5, 2, 119, 120, 7, 14, 2, 2, 120, 157, 3, 2, 2, 2, 121, 122, 7, 6, 2,
-	2, 122, 123, 7, 13, 2, 2, 123, 124, 5, 4, 3, 2, 124, 125, 7, 18, 2, 2,
-	125, 126, 5, 8, 5, 2, 126, 127, 7, 14, 2, 2, 127, 157, 3, 2, 2, 2, 128,
-	129, 7, 7, 2, 2, 129, 130, 7, 13, 2, 2, 130, 131, 5, 4, 3, 2, 131, 132,
-	7, 18, 2, 2, 132, 133, 5, 8, 5, 2, 133, 134, 7, 14, 2, 2, 134, 157, 3,
-	2, 2, 2, 135, 136, 7, 8, 2, 2, 136, 137, 7, 13, 2, 2, 137, 138, 5, 4, 3,
-	2, 138, 139, 7, 18, 2, 2, 139, 140, 5, 8, 5, 2, 140, 141, 7, 14, 2, 2,
-	141, 157, 3, 2, 2, 2, 142, 143, 7, 9, 2, 2, 143, 144, 7, 13, 2, 2, 144,
-	145, 5, 4, 3, 2, 145, 146, 7, 18, 2, 2, 146, 147, 5, 8, 5, 2, 147, 148,
-	7, 14, 2, 2, 148, 157, 3, 2, 2, 2, 149, 150, 7, 10, 2, 2, 150, 151, 7,
-	13, 2, 2, 151, 152, 5, 4, 3, 2, 152, 153, 7, 18, 2, 2, 153, 154, 5, 8,
-	5, 2, 154, 155, 7, 14, 2, 2, 155, 157, 3, 2, 2, 2, 156, 109, 3, 2, 2, 2,
-	156, 114, 3, 2, 2, 2, 156, 121, 3, 2, 2, 2, 156, 128, 3, 2, 2, 2, 156,
-	135, 3, 2, 2, 2, 156, 142, 3, 2, 2, 2, 156, 149, 3, 2, 2, 2, 157, 7, 3,
-	2, 2, 2, 158, 159, 7, 15, 2, 2, 159, 160, 5, 4, 3, 2, 160, 161, 7, 16,
-	2, 2, 161, 9, 3, 2, 2, 2, 162, 167, 5, 4, 3, 2, 163, 164, 7, 18, 2, 2,
-	164, 166, 5, 4, 3, 2, 165, 163, 3, 2, 2, 2, 166, 169, 3, 2, 2, 2, 167,
-	165, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 11, 3, 2, 2, 2, 169, 167, 3,
-	2, 2, 2, 170, 171, 7, 11, 2, 2, 171, 187, 7, 12, 2, 2, 172, 173, 7, 11,
-	2, 2, 173, 178, 5, 4, 3, 2, 174, 175, 7, 18, 2, 2, 175, 177, 5, 4, 3, 2,
-	176, 174, 3, 2, 2, 2, 177, 180, 3, 2, 2, 2, 178, 176, 3, 2, 2, 2, 178,
-	179, 3, 2, 2, 2, 179, 182, 3, 2, 2, 2, 180, 178, 3, 2, 2, 2, 181, 183,
-	7, 18, 2, 2, 182, 181, 3, 2, 2, 2, 182, 183, 3, 2, 2, 2, 183, 184, 3, 2,
-	2, 2, 184, 185, 7, 12, 2, 2, 185, 187, 3, 2, 2, 2, 186, 170, 3, 2, 2, 2,
-	186, 172, 3, 2, 2, 2, 187, 13, 3, 2, 2, 2, 188, 189, 7, 15, 2, 2, 189,
-	198, 7, 16, 2, 2, 190, 191, 7, 15, 2, 2, 191, 193, 5, 16, 9, 2, 192, 194,
-	7, 18, 2, 2, 193, 192, 3, 2, 2, 2, 193, 194, 3, 2, 2, 2, 194, 195, 3, 2,
-	2, 2, 195, 196, 7, 16, 2, 2, 196, 198, 3, 2, 2, 2, 197, 188, 3, 2, 2, 2,
-	197, 190, 3, 2, 2, 2, 198, 15, 3, 2, 2, 2, 199, 204, 5, 18, 10, 2, 200,
-	201, 7, 18, 2, 2, 201, 203, 5, 18, 10, 2, 202, 200, 3, 2, 2, 2, 203, 206,
-	3, 2, 2, 2, 204, 202, 3, 2, 2, 2, 204, 205, 3, 2, 2, 2, 205, 17, 3, 2,
-	2, 2, 206, 204, 3, 2, 2, 2, 207, 208, 5, 20, 11, 2, 208, 209, 7, 21, 2,
-	2, 209, 210, 5, 4, 3, 2, 210, 19, 3, 2, 2, 2, 211, 212, 9, 8, 2, 2, 212,
-	21, 3, 2, 2, 2, 213, 219, 7, 48, 2, 2, 214, 219, 7, 49, 2, 2, 215, 219,
-	5, 24, 13, 2, 216, 219, 5, 26, 14, 2, 217, 219, 7, 51, 2, 2, 218, 213,
-	3, 2, 2, 2, 218, 214, 3, 2, 2, 2, 218, 215, 3, 2, 2, 2, 218, 216, 3, 2,
-	2, 2, 218, 217, 3, 2, 2, 2, 219, 23, 3, 2, 2, 2, 220, 221, 7, 54, 2, 2,
-	221, 25, 3, 2, 2, 2, 222, 223, 9, 9, 2, 2, 223, 27, 3, 2, 2, 2, 15, 46,
-	101, 104, 106, 156, 167, 178, 182, 186, 193, 197, 204, 218,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 5, 3, 111, 10, 3, 3, 3, 7, 3, 114, 10, 3, 12, 3, 14, 3,
+	117, 11, 3, 3, 4, 3, 4, 3, 4, 7, 4, 122, 10, 4, 12, 4, 14, 4, 125, 11,
+	4, 3, 5, 3, 5, 5, 5, 129, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3,
+	6, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6, 142, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 147,
+	10, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 7, 7, 155, 10, 7, 12, 7, 14,
+	7, 158, 11, 7, 3, 7, 5, 7, 161, 10, 7, 3, 7, 3, 7, 5, 7, 165, 10, 7, 3,
+	8, 3, 8, 3, 8, 3, 8, 3, 8, 5, 8, 172, 10, 8, 3, 8, 3, 8, 5, 8, 176, 10,
+	8, 3, 9, 3, 9, 3, 9, 7, 9, 181, 10, 9, 12, 9, 14, 9, 184, 11, 9, 3, 10,
+	3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3,
+	12, 5, 12, 198, 10, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 2, 3, 4, 15,
+	2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 2, 11, 3, 2, 19, 22, 4,
+	2, 23, 27, 30, 32, 4, 2, 19, 20, 28, 29, 3, 2, 33, 36, 3, 2, 46, 47, 3,
+	2, 37, 38, 4, 2, 14, 14, 17, 17, 3, 2, 57, 58, 4, 2, 51, 51, 53, 55, 2,
+	232, 2, 28, 3, 2, 2, 2, 4, 52, 3, 2, 2, 2, 6, 118, 3, 2, 2, 2, 8, 128,
+	3, 2, 2, 2, 10, 146, 3, 2, 2, 2, 12, 164, 3, 2, 2, 2, 14, 175, 3, 2, 2,
+	2, 16, 177, 3, 2, 2, 2, 18, 185, 3, 2, 2, 2, 20, 189, 3, 2, 2, 2, 22, 197,
+	3, 2, 2, 2, 24, 199, 3, 2, 2, 2, 26, 201, 3, 2, 2, 2, 28, 29, 5, 4, 3,
+	2, 29, 30, 7, 2, 2, 3, 30, 3, 3, 2, 2, 2, 31, 32, 8, 3, 1, 2, 32, 33, 7,
+	17, 2, 2, 33, 53, 7, 57, 2, 2, 34, 35, 9, 2, 2, 2, 35, 53, 5, 4, 3, 24,
+	36, 53, 7, 57, 2, 2, 37, 53, 7, 39, 2, 2, 38, 53, 5, 22, 12, 2, 39, 53,
+	5, 12, 7, 2, 40, 53, 5, 14, 8, 2, 41, 42, 7, 5, 2, 2, 42, 43, 5, 4, 3,
+	2, 43, 44, 7, 6, 2, 2, 44, 53, 3, 2, 2, 2, 45, 46, 7, 48, 2, 2, 46, 47,
+	7, 57, 2, 2, 47, 48, 7, 11, 2, 2, 48, 49, 5, 4, 3, 2, 49, 50, 7, 9, 2,
+	2, 50, 51, 5, 4, 3, 3, 51, 53, 3, 2, 2, 2, 52, 31, 3, 2, 2, 2, 52, 34,
+	3, 2, 2, 2, 52, 36, 3, 2, 2, 2, 52, 37, 3, 2, 2, 2, 52, 38, 3, 2, 2, 2,
+	52, 39, 3, 2, 2, 2, 52, 40, 3, 2, 2, 2, 52, 41, 3, 2, 2, 2, 52, 45, 3,
+	2, 2, 2, 53, 115, 3, 2, 2, 2, 54, 55, 12, 23, 2, 2, 55, 56, 7, 18, 2, 2,
+	56, 114, 5, 4, 3, 24, 57, 58, 12, 22, 2, 2, 58, 59, 9, 3, 2, 2, 59, 114,
+	5, 4, 3, 23, 60, 61, 12, 21, 2, 2, 61, 62, 9, 4, 2, 2, 62, 114, 5, 4, 3,
+	22, 63, 64, 12, 20, 2, 2, 64, 65, 9, 5, 2, 2, 65, 114, 5, 4, 3, 21, 66,
+	67, 12, 19, 2, 2, 67, 68, 7, 42, 2, 2, 68, 114, 5, 4, 3, 20, 69, 70, 12,
+	18, 2, 2, 70, 71, 7, 43, 2, 2, 71, 114, 5, 4, 3, 19, 72, 73, 12, 17, 2,
+	2, 73, 74, 7, 44, 2, 2, 74, 114, 5, 4, 3, 18, 75, 76, 12, 16, 2, 2, 76,
+	77, 7, 45, 2, 2, 77, 114, 5, 4, 3, 17, 78, 79, 12, 15, 2, 2, 79, 80, 9,
+	6, 2, 2, 80, 114, 5, 4, 3, 16, 81, 82, 12, 14, 2, 2, 82, 83, 9, 7, 2, 2,
+	83, 114, 5, 4, 3, 15, 84, 85, 12, 13, 2, 2, 85, 86, 7, 40, 2, 2, 86, 114,
+	5, 4, 3, 14, 87, 88, 12, 12, 2, 2, 88, 89, 7, 41, 2, 2, 89, 114, 5, 4,
+	3, 13, 90, 91, 12, 11, 2, 2, 91, 92, 7, 15, 2, 2, 92, 114, 5, 4, 3, 12,
+	93, 94, 12, 10, 2, 2, 94, 95, 7, 13, 2, 2, 95, 96, 5, 4, 3, 2, 96, 97,
+	7, 16, 2, 2, 97, 98, 5, 4, 3, 11, 98, 114, 3, 2, 2, 2, 99, 100, 12, 27,
+	2, 2, 100, 101, 7, 3, 2, 2, 101, 102, 5, 4, 3, 2, 102, 103, 7, 4, 2, 2,
+	103, 114, 3, 2, 2, 2, 104, 105, 12, 26, 2, 2, 105, 106, 9, 8, 2, 2, 106,
+	114, 7, 57, 2, 2, 107, 108, 12, 25, 2, 2, 108, 110, 7, 5, 2, 2, 109, 111,
+	5, 6, 4, 2, 110, 109, 3, 2, 2, 2, 110, 111, 3, 2, 2, 2, 111, 112, 3, 2,
+	2, 2, 112, 114, 7, 6, 2, 2, 113, 54, 3, 2, 2, 2, 113, 57, 3, 2, 2, 2, 113,
+	60, 3, 2, 2, 2, 113, 63, 3, 2, 2, 2, 113, 66, 3, 2, 2, 2, 113, 69, 3, 2,
+	2, 2, 113, 72, 3, 2, 2, 2, 113, 75, 3, 2, 2, 2, 113, 78, 3, 2, 2, 2, 113,
+	81, 3, 2, 2, 2, 113, 84, 3, 2, 2, 2, 113, 87, 3, 2, 2, 2, 113, 90, 3, 2,
+	2, 2, 113, 93, 3, 2, 2, 2, 113, 99, 3, 2, 2, 2, 113, 104, 3, 2, 2, 2, 113,
+	107, 3, 2, 2, 2, 114, 117, 3, 2, 2, 2, 115, 113, 3, 2, 2, 2, 115, 116,
+	3, 2, 2, 2, 116, 5, 3, 2, 2, 2, 117, 115, 3, 2, 2, 2, 118, 123, 5, 8, 5,
+	2, 119, 120, 7, 10, 2, 2, 120, 122, 5, 8, 5, 2, 121, 119, 3, 2, 2, 2, 122,
+	125, 3, 2, 2, 2, 123, 121, 3, 2, 2, 2, 123, 124, 3, 2, 2, 2, 124, 7, 3,
+	2, 2, 2, 125, 123, 3, 2, 2, 2, 126, 129, 5, 10, 6, 2, 127, 129, 5, 4, 3,
+	2, 128, 126, 3, 2, 2, 2, 128, 127, 3, 2, 2, 2, 129, 9, 3, 2, 2, 2, 130,
+	131, 7, 7, 2, 2, 131, 132, 5, 4, 3, 2, 132, 133, 7, 8, 2, 2, 133, 147,
+	3, 2, 2, 2, 134, 135, 7, 57, 2, 2, 135, 136, 7, 12, 2, 2, 136, 147, 5,
+	4, 3, 2, 137, 138, 7, 5, 2, 2, 138, 141, 7, 57, 2, 2, 139, 140, 7, 10,
+	2, 2, 140, 142, 7, 57, 2, 2, 141, 139, 3, 2, 2, 2, 141, 142, 3, 2, 2, 2,
+	142, 143, 3, 2, 2, 2, 143, 144, 7, 6, 2, 2, 144, 145, 7, 12, 2, 2, 145,
+	147, 5, 4, 3, 2, 146, 130, 3, 2, 2, 2, 146, 134, 3, 2, 2, 2, 146, 137,
+	3, 2, 2, 2, 147, 11, 3, 2, 2, 2, 148, 149, 7, 3, 2, 2, 149, 165, 7, 4,
+	2, 2, 150, 151, 7, 3, 2, 2, 151, 156, 5, 4, 3, 2, 152, 153, 7, 10, 2, 2,
+	153, 155, 5, 4, 3, 2, 154, 152, 3, 2, 2, 2, 155, 158, 3, 2, 2, 2, 156,
+	154, 3, 2, 2, 2, 156, 157, 3, 2, 2, 2, 157, 160, 3, 2, 2, 2, 158, 156,
+	3, 2, 2, 2, 159, 161, 7, 10, 2, 2, 160, 159, 3, 2, 2, 2, 160, 161, 3, 2,
+	2, 2, 161, 162, 3, 2, 2, 2, 162, 163, 7, 4, 2, 2, 163, 165, 3, 2, 2, 2,
+	164, 148, 3, 2, 2, 2, 164, 150, 3, 2, 2, 2, 165, 13, 3, 2, 2, 2, 166, 167,
+	7, 7, 2, 2, 167, 176, 7, 8, 2, 2, 168, 169, 7, 7, 2, 2, 169, 171, 5, 16,
+	9, 2, 170, 172, 7, 10, 2, 2, 171, 170, 3, 2, 2, 2, 171, 172, 3, 2, 2, 2,
+	172, 173, 3, 2, 2, 2, 173, 174, 7, 8, 2, 2, 174, 176, 3, 2, 2, 2, 175,
+	166, 3, 2, 2, 2, 175, 168, 3, 2, 2, 2, 176, 15, 3, 2, 2, 2, 177, 182, 5,
+	18, 10, 2, 178, 179, 7, 10, 2, 2, 179, 181, 5, 18, 10, 2, 180, 178, 3,
+	2, 2, 2, 181, 184, 3, 2, 2, 2, 182, 180, 3, 2, 2, 2, 182, 183, 3, 2, 2,
+	2, 183, 17, 3, 2, 2, 2, 184, 182, 3, 2, 2, 2, 185, 186, 5, 20, 11, 2, 186,
+	187, 7, 16, 2, 2, 187, 188, 5, 4, 3, 2, 188, 19, 3, 2, 2, 2, 189, 190,
+	9, 9, 2, 2, 190, 21, 3, 2, 2, 2, 191, 198, 7, 49, 2, 2, 192, 198, 7, 50,
+	2, 2, 193, 198, 5, 24, 13, 2, 194, 198, 5, 26, 14, 2, 195, 198, 7, 52,
+	2, 2, 196, 198, 7, 56, 2, 2, 197, 191, 3, 2, 2, 2, 197, 192, 3, 2, 2, 2,
+	197, 193, 3, 2, 2, 2, 197, 194, 3, 2, 2, 2, 197, 195, 3, 2, 2, 2, 197,
+	196, 3, 2, 2, 2, 198, 23, 3, 2, 2, 2, 199, 200, 7, 58, 2, 2, 200, 25, 3,
+	2, 2, 2, 201, 202, 9, 10, 2, 2, 202, 27, 3, 2, 2, 2, 17, 52, 110, 113,
+	115, 123, 128, 141, 146, 156, 160, 164, 171, 175, 182, 197,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'..'", "'len'", "'all'", "'none'", "'any'", "'one'", "'filter'", "'map'",
-	"'['", "']'", "'('", "')'", "'{'", "'}'", "';'", "','", "'='", "'?'", "':'",
-	"'.'", "'+'", "'-'", "", "'*'", "'**'", "'/'", "'%'", "'>>'", "'<<'", "'<'",
-	"'>'", "'<='", "'>='", "'=='", "'!='", "'#'", "", "", "", "'startsWith'",
-	"'endsWith'", "'contains'", "'matches'", "'in'", "'not in'", "'nil'",
+	"", "'['", "']'", "'('", "')'", "'{'", "'}'", "';'", "','", "'='", "'=>'",
+	"'?'", "'?.'", "'??'", "':'", "'.'", "'..'", "'+'", "'-'", "", "'~'", "'*'",
+	"'**'", "'/'", "'%'", "'&'", "'|'", "'^'", "'&^'", "'>>'", "'<<'", "'<'",
+	"'>'", "'<='", "'>='", "'=='", "'!='", "", "", "", "'startsWith'", "'endsWith'",
+	"'contains'", "'matches'", "'in'", "'not in'", "'let'", "'nil'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "OpenBracket", "CloseBracket", "OpenParen",
-	"CloseParen", "OpenBrace", "CloseBrace", "SemiColon", "Comma", "Assign",
-	"QuestionMark", "Colon", "Dot", "Plus", "Minus", "Not", "Multiply", "Exponent",
-	"Divide", "Modulus", "RightShiftArithmetic", "LeftShiftArithmetic", "LessThan",
-	"MoreThan", "LessThanEquals", "GreaterThanEquals", "Equals", "NotEquals",
-	"Pointer", "And", "Or", "Builtins", "StartsWith", "EndsWith", "Contains",
-	"Matches", "In", "NotIn", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
-	"FloatLiteral", "HexIntegerLiteral", "Identifier", "StringLiteral", "WhiteSpaces",
-	"MultiLineComment", "SingleLineComment", "LineTerminator", "UnexpectedCharacter",
+	"", "OpenBracket", "CloseBracket", "OpenParen", "CloseParen", "OpenBrace",
+	"CloseBrace", "SemiColon", "Comma", "Assign", "Arrow", "QuestionMark",
+	"QuestionDot", "NilCoalescing", "Colon", "Dot", "Range", "Plus", "Minus",
+	"Not", "BitNot", "Multiply", "Exponent", "Divide", "Modulus", "BitAnd",
+	"BitOr", "BitXor", "BitClear", "RightShiftArithmetic", "LeftShiftArithmetic",
+	"LessThan", "MoreThan", "LessThanEquals", "GreaterThanEquals", "Equals",
+	"NotEquals", "Pointer", "And", "Or", "StartsWith", "EndsWith", "Contains",
+	"Matches", "In", "NotIn", "Let", "NilLiteral", "BooleanLiteral", "IntegerLiteral",
+	"FloatLiteral", "HexIntegerLiteral", "OctalIntegerLiteral", "BinaryIntegerLiteral",
+	"DurationLiteral", "Identifier", "StringLiteral", "WhiteSpaces", "MultiLineComment",
+	"SingleLineComment", "LineTerminator", "UnexpectedCharacter",
 }
 
 var ruleNames = []string{
-	"start", "expr", "builtins", "closure", "arguments", "arrayLiteral", "mapLiteral",
+	"start", "expr", "arguments", "argument", "closure", "arrayLiteral", "mapLiteral",
 	"propertyNameAndValueList", "propertyAssignment", "propertyName", "literal",
 	"stringLiteral", "integerLiteral",
 }
@@ -173,72 +167,76 @@ func NewExprParser(input antlr.TokenStream) *ExprParser {
 // ExprParser tokens.
 const (
 	ExprParserEOF                  = antlr.TokenEOF
-	ExprParserT__0                 = 1
-	ExprParserT__1                 = 2
-	ExprParserT__2                 = 3
-	ExprParserT__3                 = 4
-	ExprParserT__4                 = 5
-	ExprParserT__5                 = 6
-	ExprParserT__6                 = 7
-	ExprParserT__7                 = 8
-	ExprParserOpenBracket          = 9
-	ExprParserCloseBracket         = 10
-	ExprParserOpenParen            = 11
-	ExprParserCloseParen           = 12
-	ExprParserOpenBrace            = 13
-	ExprParserCloseBrace           = 14
-	ExprParserSemiColon            = 15
-	ExprParserComma                = 16
-	ExprParserAssign               = 17
-	ExprParserQuestionMark         = 18
-	ExprParserColon                = 19
-	ExprParserDot                  = 20
-	ExprParserPlus                 = 21
-	ExprParserMinus                = 22
-	ExprParserNot                  = 23
-	ExprParserMultiply             = 24
-	ExprParserExponent             = 25
-	ExprParserDivide               = 26
-	ExprParserModulus              = 27
-	ExprParserRightShiftArithmetic = 28
-	ExprParserLeftShiftArithmetic  = 29
-	ExprParserLessThan             = 30
-	ExprParserMoreThan             = 31
-	ExprParserLessThanEquals       = 32
-	ExprParserGreaterThanEquals    = 33
-	ExprParserEquals               = 34
-	ExprParserNotEquals            = 35
-	ExprParserPointer              = 36
-	ExprParserAnd                  = 37
-	ExprParserOr                   = 38
-	ExprParserBuiltins             = 39
+	ExprParserOpenBracket          = 1
+	ExprParserCloseBracket         = 2
+	ExprParserOpenParen            = 3
+	ExprParserCloseParen           = 4
+	ExprParserOpenBrace            = 5
+	ExprParserCloseBrace           = 6
+	ExprParserSemiColon            = 7
+	ExprParserComma                = 8
+	ExprParserAssign               = 9
+	ExprParserArrow                = 10
+	ExprParserQuestionMark         = 11
+	ExprParserQuestionDot          = 12
+	ExprParserNilCoalescing        = 13
+	ExprParserColon                = 14
+	ExprParserDot                  = 15
+	ExprParserRange                = 16
+	ExprParserPlus                 = 17
+	ExprParserMinus                = 18
+	ExprParserNot                  = 19
+	ExprParserBitNot               = 20
+	ExprParserMultiply             = 21
+	ExprParserExponent             = 22
+	ExprParserDivide               = 23
+	ExprParserModulus              = 24
+	ExprParserBitAnd               = 25
+	ExprParserBitOr                = 26
+	ExprParserBitXor               = 27
+	ExprParserBitClear             = 28
+	ExprParserRightShiftArithmetic = 29
+	ExprParserLeftShiftArithmetic  = 30
+	ExprParserLessThan             = 31
+	ExprParserMoreThan             = 32
+	ExprParserLessThanEquals       = 33
+	ExprParserGreaterThanEquals    = 34
+	ExprParserEquals               = 35
+	ExprParserNotEquals            = 36
+	ExprParserPointer              = 37
+	ExprParserAnd                  = 38
+	ExprParserOr                   = 39
 	ExprParserStartsWith           = 40
 	ExprParserEndsWith             = 41
 	ExprParserContains             = 42
 	ExprParserMatches              = 43
 	ExprParserIn                   = 44
 	ExprParserNotIn                = 45
-	ExprParserNilLiteral           = 46
-	ExprParserBooleanLiteral       = 47
-	ExprParserIntegerLiteral       = 48
-	ExprParserFloatLiteral         = 49
-	ExprParserHexIntegerLiteral    = 50
-	ExprParserIdentifier           = 51
-	ExprParserStringLiteral        = 52
-	ExprParserWhiteSpaces          = 53
-	ExprParserMultiLineComment     = 54
-	ExprParserSingleLineComment    = 55
-	ExprParserLineTerminator       = 56
-	ExprParserUnexpectedCharacter  = 57
+	ExprParserLet                  = 46
+	ExprParserNilLiteral           = 47
+	ExprParserBooleanLiteral       = 48
+	ExprParserIntegerLiteral       = 49
+	ExprParserFloatLiteral         = 50
+	ExprParserHexIntegerLiteral    = 51
+	ExprParserOctalIntegerLiteral  = 52
+	ExprParserBinaryIntegerLiteral = 53
+	ExprParserDurationLiteral      = 54
+	ExprParserIdentifier           = 55
+	ExprParserStringLiteral        = 56
+	ExprParserWhiteSpaces          = 57
+	ExprParserMultiLineComment     = 58
+	ExprParserSingleLineComment    = 59
+	ExprParserLineTerminator       = 60
+	ExprParserUnexpectedCharacter  = 61
 )
 
 // ExprParser rules.
 const (
 	ExprParserRULE_start                    = 0
 	ExprParserRULE_expr                     = 1
-	ExprParserRULE_builtins                 = 2
-	ExprParserRULE_closure                  = 3
-	ExprParserRULE_arguments                = 4
+	ExprParserRULE_arguments                = 2
+	ExprParserRULE_argument                 = 3
+	ExprParserRULE_closure                  = 4
 	ExprParserRULE_arrayLiteral             = 5
 	ExprParserRULE_mapLiteral               = 6
 	ExprParserRULE_propertyNameAndValueList = 7
@@ -428,12 +426,14 @@ func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) s
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-type ParenthesizedExpressionContext struct {
+type TernaryExpressionContext struct {
 	*ExprContext
+	e1 IExprContext
+	e2 IExprContext
 }
 
-func NewParenthesizedExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenthesizedExpressionContext {
-	var p = new(ParenthesizedExpressionContext)
+func NewTernaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TernaryExpressionContext {
+	var p = new(TernaryExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -442,16 +442,33 @@ func NewParenthesizedExpressionContext(parser antlr.Parser, ctx antlr.ParserRule
 	return p
 }
 
-func (s *ParenthesizedExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *TernaryExpressionContext) GetE1() IExprContext { return s.e1 }
+
+func (s *TernaryExpressionContext) GetE2() IExprContext { return s.e2 }
+
+func (s *TernaryExpressionContext) SetE1(v IExprContext) { s.e1 = v }
+
+func (s *TernaryExpressionContext) SetE2(v IExprContext) { s.e2 = v }
+
+func (s *TernaryExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParenthesizedExpressionContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenParen, 0)
+func (s *TernaryExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *ParenthesizedExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *TernaryExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -460,39 +477,43 @@ func (s *ParenthesizedExpressionContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ParenthesizedExpressionContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseParen, 0)
+func (s *TernaryExpressionContext) QuestionMark() antlr.TerminalNode {
+	return s.GetToken(ExprParserQuestionMark, 0)
 }
 
-func (s *ParenthesizedExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TernaryExpressionContext) Colon() antlr.TerminalNode {
+	return s.GetToken(ExprParserColon, 0)
+}
+
+func (s *TernaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterParenthesizedExpression(s)
+		listenerT.EnterTernaryExpression(s)
 	}
 }
 
-func (s *ParenthesizedExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TernaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitParenthesizedExpression(s)
+		listenerT.ExitTernaryExpression(s)
 	}
 }
 
-func (s *ParenthesizedExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TernaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitParenthesizedExpression(s)
+		return t.VisitTernaryExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AdditiveExpressionContext struct {
+type NilCoalescingExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExpressionContext {
-	var p = new(AdditiveExpressionContext)
+func NewNilCoalescingExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NilCoalescingExpressionContext {
+	var p = new(NilCoalescingExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -501,15 +522,15 @@ func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleConte
 	return p
 }
 
-func (s *AdditiveExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *NilCoalescingExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *AdditiveExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *NilCoalescingExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *NilCoalescingExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AdditiveExpressionContext) AllExpr() []IExprContext {
+func (s *NilCoalescingExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -522,7 +543,7 @@ func (s *AdditiveExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *AdditiveExpressionContext) Expr(i int) IExprContext {
+func (s *NilCoalescingExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -532,43 +553,39 @@ func (s *AdditiveExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *AdditiveExpressionContext) Plus() antlr.TerminalNode {
-	return s.GetToken(ExprParserPlus, 0)
-}
-
-func (s *AdditiveExpressionContext) Minus() antlr.TerminalNode {
-	return s.GetToken(ExprParserMinus, 0)
+func (s *NilCoalescingExpressionContext) NilCoalescing() antlr.TerminalNode {
+	return s.GetToken(ExprParserNilCoalescing, 0)
 }
 
-func (s *AdditiveExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NilCoalescingExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterAdditiveExpression(s)
+		listenerT.EnterNilCoalescingExpression(s)
 	}
 }
 
-func (s *AdditiveExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NilCoalescingExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitAdditiveExpression(s)
+		listenerT.ExitNilCoalescingExpression(s)
 	}
 }
 
-func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NilCoalescingExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitAdditiveExpression(s)
+		return t.VisitNilCoalescingExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type RelationalExpressionContext struct {
+type InExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewRelationalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RelationalExpressionContext {
-	var p = new(RelationalExpressionContext)
+func NewInExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InExpressionContext {
+	var p = new(InExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -577,15 +594,15 @@ func NewRelationalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCon
 	return p
 }
 
-func (s *RelationalExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *InExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *RelationalExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *InExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *RelationalExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *InExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RelationalExpressionContext) AllExpr() []IExprContext {
+func (s *InExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -598,7 +615,7 @@ func (s *RelationalExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *RelationalExpressionContext) Expr(i int) IExprContext {
+func (s *InExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -608,52 +625,43 @@ func (s *RelationalExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *RelationalExpressionContext) LessThan() antlr.TerminalNode {
-	return s.GetToken(ExprParserLessThan, 0)
-}
-
-func (s *RelationalExpressionContext) MoreThan() antlr.TerminalNode {
-	return s.GetToken(ExprParserMoreThan, 0)
-}
-
-func (s *RelationalExpressionContext) LessThanEquals() antlr.TerminalNode {
-	return s.GetToken(ExprParserLessThanEquals, 0)
+func (s *InExpressionContext) In() antlr.TerminalNode {
+	return s.GetToken(ExprParserIn, 0)
 }
 
-func (s *RelationalExpressionContext) GreaterThanEquals() antlr.TerminalNode {
-	return s.GetToken(ExprParserGreaterThanEquals, 0)
+func (s *InExpressionContext) NotIn() antlr.TerminalNode {
+	return s.GetToken(ExprParserNotIn, 0)
 }
 
-func (s *RelationalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterRelationalExpression(s)
+		listenerT.EnterInExpression(s)
 	}
 }
 
-func (s *RelationalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitRelationalExpression(s)
+		listenerT.ExitInExpression(s)
 	}
 }
 
-func (s *RelationalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitRelationalExpression(s)
+		return t.VisitInExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type TernaryExpressionContext struct {
+type UnaryExpressionContext struct {
 	*ExprContext
-	e1 IExprContext
-	e2 IExprContext
+	op antlr.Token
 }
 
-func NewTernaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TernaryExpressionContext {
-	var p = new(TernaryExpressionContext)
+func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExpressionContext {
+	var p = new(UnaryExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -662,33 +670,16 @@ func NewTernaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContex
 	return p
 }
 
-func (s *TernaryExpressionContext) GetE1() IExprContext { return s.e1 }
-
-func (s *TernaryExpressionContext) GetE2() IExprContext { return s.e2 }
-
-func (s *TernaryExpressionContext) SetE1(v IExprContext) { s.e1 = v }
+func (s *UnaryExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *TernaryExpressionContext) SetE2(v IExprContext) { s.e2 = v }
+func (s *UnaryExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *TernaryExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TernaryExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *TernaryExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *UnaryExpressionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -697,43 +688,51 @@ func (s *TernaryExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *TernaryExpressionContext) QuestionMark() antlr.TerminalNode {
-	return s.GetToken(ExprParserQuestionMark, 0)
+func (s *UnaryExpressionContext) Plus() antlr.TerminalNode {
+	return s.GetToken(ExprParserPlus, 0)
 }
 
-func (s *TernaryExpressionContext) Colon() antlr.TerminalNode {
-	return s.GetToken(ExprParserColon, 0)
+func (s *UnaryExpressionContext) Minus() antlr.TerminalNode {
+	return s.GetToken(ExprParserMinus, 0)
 }
 
-func (s *TernaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnaryExpressionContext) Not() antlr.TerminalNode {
+	return s.GetToken(ExprParserNot, 0)
+}
+
+func (s *UnaryExpressionContext) BitNot() antlr.TerminalNode {
+	return s.GetToken(ExprParserBitNot, 0)
+}
+
+func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterTernaryExpression(s)
+		listenerT.EnterUnaryExpression(s)
 	}
 }
 
-func (s *TernaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitTernaryExpression(s)
+		listenerT.ExitUnaryExpression(s)
 	}
 }
 
-func (s *TernaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitTernaryExpression(s)
+		return t.VisitUnaryExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ContainsExpressionContext struct {
+type RangeExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewContainsExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ContainsExpressionContext {
-	var p = new(ContainsExpressionContext)
+func NewRangeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RangeExpressionContext {
+	var p = new(RangeExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -742,15 +741,15 @@ func NewContainsExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleConte
 	return p
 }
 
-func (s *ContainsExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *RangeExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *ContainsExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *RangeExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *ContainsExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *RangeExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ContainsExpressionContext) AllExpr() []IExprContext {
+func (s *RangeExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -763,7 +762,7 @@ func (s *ContainsExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *ContainsExpressionContext) Expr(i int) IExprContext {
+func (s *RangeExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -773,40 +772,39 @@ func (s *ContainsExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ContainsExpressionContext) Contains() antlr.TerminalNode {
-	return s.GetToken(ExprParserContains, 0)
+func (s *RangeExpressionContext) Range() antlr.TerminalNode {
+	return s.GetToken(ExprParserRange, 0)
 }
 
-func (s *ContainsExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RangeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterContainsExpression(s)
+		listenerT.EnterRangeExpression(s)
 	}
 }
 
-func (s *ContainsExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RangeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitContainsExpression(s)
+		listenerT.ExitRangeExpression(s)
 	}
 }
 
-func (s *ContainsExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RangeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitContainsExpression(s)
+		return t.VisitRangeExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MatchesExpressionContext struct {
+type LogicalExpressionContext struct {
 	*ExprContext
-	op      antlr.Token
-	pattern IExprContext
+	op antlr.Token
 }
 
-func NewMatchesExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MatchesExpressionContext {
-	var p = new(MatchesExpressionContext)
+func NewLogicalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalExpressionContext {
+	var p = new(LogicalExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -815,19 +813,15 @@ func NewMatchesExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContex
 	return p
 }
 
-func (s *MatchesExpressionContext) GetOp() antlr.Token { return s.op }
-
-func (s *MatchesExpressionContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *MatchesExpressionContext) GetPattern() IExprContext { return s.pattern }
+func (s *LogicalExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *MatchesExpressionContext) SetPattern(v IExprContext) { s.pattern = v }
+func (s *LogicalExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *MatchesExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *LogicalExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MatchesExpressionContext) AllExpr() []IExprContext {
+func (s *LogicalExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -840,7 +834,7 @@ func (s *MatchesExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *MatchesExpressionContext) Expr(i int) IExprContext {
+func (s *LogicalExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -850,38 +844,45 @@ func (s *MatchesExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *MatchesExpressionContext) Matches() antlr.TerminalNode {
-	return s.GetToken(ExprParserMatches, 0)
+func (s *LogicalExpressionContext) And() antlr.TerminalNode {
+	return s.GetToken(ExprParserAnd, 0)
 }
 
-func (s *MatchesExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LogicalExpressionContext) Or() antlr.TerminalNode {
+	return s.GetToken(ExprParserOr, 0)
+}
+
+func (s *LogicalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterMatchesExpression(s)
+		listenerT.EnterLogicalExpression(s)
 	}
 }
 
-func (s *MatchesExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LogicalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitMatchesExpression(s)
+		listenerT.ExitLogicalExpression(s)
 	}
 }
 
-func (s *MatchesExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LogicalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitMatchesExpression(s)
+		return t.VisitLogicalExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MapLiteralExpressionContext struct {
+type LetExpressionContext struct {
 	*ExprContext
+	name  antlr.Token
+	value IExprContext
+	body  IExprContext
 }
 
-func NewMapLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapLiteralExpressionContext {
-	var p = new(MapLiteralExpressionContext)
+func NewLetExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LetExpressionContext {
+	var p = new(LetExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -890,99 +891,90 @@ func NewMapLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCon
 	return p
 }
 
-func (s *MapLiteralExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *LetExpressionContext) GetName() antlr.Token { return s.name }
 
-func (s *MapLiteralExpressionContext) MapLiteral() IMapLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapLiteralContext)(nil)).Elem(), 0)
+func (s *LetExpressionContext) SetName(v antlr.Token) { s.name = v }
 
-	if t == nil {
-		return nil
-	}
+func (s *LetExpressionContext) GetValue() IExprContext { return s.value }
 
-	return t.(IMapLiteralContext)
-}
+func (s *LetExpressionContext) GetBody() IExprContext { return s.body }
 
-func (s *MapLiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterMapLiteralExpression(s)
-	}
-}
+func (s *LetExpressionContext) SetValue(v IExprContext) { s.value = v }
 
-func (s *MapLiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitMapLiteralExpression(s)
-	}
+func (s *LetExpressionContext) SetBody(v IExprContext) { s.body = v }
+
+func (s *LetExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *MapLiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ExprVisitor:
-		return t.VisitMapLiteralExpression(s)
+func (s *LetExpressionContext) Let() antlr.TerminalNode {
+	return s.GetToken(ExprParserLet, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *LetExpressionContext) Assign() antlr.TerminalNode {
+	return s.GetToken(ExprParserAssign, 0)
 }
 
-type LiteralExpressionContext struct {
-	*ExprContext
+func (s *LetExpressionContext) SemiColon() antlr.TerminalNode {
+	return s.GetToken(ExprParserSemiColon, 0)
 }
 
-func NewLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExpressionContext {
-	var p = new(LiteralExpressionContext)
+func (s *LetExpressionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(ExprParserIdentifier, 0)
+}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *LetExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
-	return p
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
 
-func (s *LiteralExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *LiteralExpressionContext) Literal() ILiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
+func (s *LetExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IExprContext)
 }
 
-func (s *LiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LetExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterLiteralExpression(s)
+		listenerT.EnterLetExpression(s)
 	}
 }
 
-func (s *LiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LetExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitLiteralExpression(s)
+		listenerT.ExitLetExpression(s)
 	}
 }
 
-func (s *LiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LetExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitLiteralExpression(s)
+		return t.VisitLetExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type InExpressionContext struct {
+type EndsWithExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewInExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InExpressionContext {
-	var p = new(InExpressionContext)
+func NewEndsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EndsWithExpressionContext {
+	var p = new(EndsWithExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -991,15 +983,15 @@ func NewInExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *I
 	return p
 }
 
-func (s *InExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *EndsWithExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *InExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *EndsWithExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *InExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *EndsWithExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InExpressionContext) AllExpr() []IExprContext {
+func (s *EndsWithExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1012,7 +1004,7 @@ func (s *InExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *InExpressionContext) Expr(i int) IExprContext {
+func (s *EndsWithExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1022,42 +1014,39 @@ func (s *InExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *InExpressionContext) In() antlr.TerminalNode {
-	return s.GetToken(ExprParserIn, 0)
-}
-
-func (s *InExpressionContext) NotIn() antlr.TerminalNode {
-	return s.GetToken(ExprParserNotIn, 0)
+func (s *EndsWithExpressionContext) EndsWith() antlr.TerminalNode {
+	return s.GetToken(ExprParserEndsWith, 0)
 }
 
-func (s *InExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EndsWithExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterInExpression(s)
+		listenerT.EnterEndsWithExpression(s)
 	}
 }
 
-func (s *InExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EndsWithExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitInExpression(s)
+		listenerT.ExitEndsWithExpression(s)
 	}
 }
 
-func (s *InExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EndsWithExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitInExpression(s)
+		return t.VisitEndsWithExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ArrayLiteralExpressionContext struct {
+type StartsWithExpressionContext struct {
 	*ExprContext
+	op antlr.Token
 }
 
-func NewArrayLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayLiteralExpressionContext {
-	var p = new(ArrayLiteralExpressionContext)
+func NewStartsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StartsWithExpressionContext {
+	var p = new(StartsWithExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1066,49 +1055,70 @@ func NewArrayLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleC
 	return p
 }
 
-func (s *ArrayLiteralExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *StartsWithExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *StartsWithExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *StartsWithExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayLiteralExpressionContext) ArrayLiteral() IArrayLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLiteralContext)(nil)).Elem(), 0)
+func (s *StartsWithExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *StartsWithExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IArrayLiteralContext)
+	return t.(IExprContext)
 }
 
-func (s *ArrayLiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StartsWithExpressionContext) StartsWith() antlr.TerminalNode {
+	return s.GetToken(ExprParserStartsWith, 0)
+}
+
+func (s *StartsWithExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterArrayLiteralExpression(s)
+		listenerT.EnterStartsWithExpression(s)
 	}
 }
 
-func (s *ArrayLiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StartsWithExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitArrayLiteralExpression(s)
+		listenerT.ExitStartsWithExpression(s)
 	}
 }
 
-func (s *ArrayLiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StartsWithExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitArrayLiteralExpression(s)
+		return t.VisitStartsWithExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MemberDotExpressionContext struct {
+type EqualityExpressionContext struct {
 	*ExprContext
-	name antlr.Token
+	op antlr.Token
 }
 
-func NewMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberDotExpressionContext {
-	var p = new(MemberDotExpressionContext)
+func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExpressionContext {
+	var p = new(EqualityExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1117,16 +1127,29 @@ func NewMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCont
 	return p
 }
 
-func (s *MemberDotExpressionContext) GetName() antlr.Token { return s.name }
+func (s *EqualityExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *MemberDotExpressionContext) SetName(v antlr.Token) { s.name = v }
+func (s *EqualityExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *MemberDotExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MemberDotExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *EqualityExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -1135,43 +1158,43 @@ func (s *MemberDotExpressionContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *MemberDotExpressionContext) Dot() antlr.TerminalNode {
-	return s.GetToken(ExprParserDot, 0)
+func (s *EqualityExpressionContext) Equals() antlr.TerminalNode {
+	return s.GetToken(ExprParserEquals, 0)
 }
 
-func (s *MemberDotExpressionContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(ExprParserIdentifier, 0)
+func (s *EqualityExpressionContext) NotEquals() antlr.TerminalNode {
+	return s.GetToken(ExprParserNotEquals, 0)
 }
 
-func (s *MemberDotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterMemberDotExpression(s)
+		listenerT.EnterEqualityExpression(s)
 	}
 }
 
-func (s *MemberDotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitMemberDotExpression(s)
+		listenerT.ExitEqualityExpression(s)
 	}
 }
 
-func (s *MemberDotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitMemberDotExpression(s)
+		return t.VisitEqualityExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type UnaryExpressionContext struct {
+type MultiplicativeExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExpressionContext {
-	var p = new(UnaryExpressionContext)
+func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicativeExpressionContext {
+	var p = new(MultiplicativeExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1180,16 +1203,29 @@ func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *UnaryExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *MultiplicativeExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *UnaryExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *MultiplicativeExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *MultiplicativeExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiplicativeExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -1198,47 +1234,67 @@ func (s *UnaryExpressionContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *UnaryExpressionContext) Plus() antlr.TerminalNode {
-	return s.GetToken(ExprParserPlus, 0)
+func (s *MultiplicativeExpressionContext) Multiply() antlr.TerminalNode {
+	return s.GetToken(ExprParserMultiply, 0)
 }
 
-func (s *UnaryExpressionContext) Minus() antlr.TerminalNode {
-	return s.GetToken(ExprParserMinus, 0)
+func (s *MultiplicativeExpressionContext) Exponent() antlr.TerminalNode {
+	return s.GetToken(ExprParserExponent, 0)
 }
 
-func (s *UnaryExpressionContext) Not() antlr.TerminalNode {
-	return s.GetToken(ExprParserNot, 0)
+func (s *MultiplicativeExpressionContext) Divide() antlr.TerminalNode {
+	return s.GetToken(ExprParserDivide, 0)
 }
 
-func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterUnaryExpression(s)
-	}
+func (s *MultiplicativeExpressionContext) Modulus() antlr.TerminalNode {
+	return s.GetToken(ExprParserModulus, 0)
 }
 
-func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitUnaryExpression(s)
-	}
+func (s *MultiplicativeExpressionContext) BitAnd() antlr.TerminalNode {
+	return s.GetToken(ExprParserBitAnd, 0)
 }
 
-func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ExprVisitor:
-		return t.VisitUnaryExpression(s)
+func (s *MultiplicativeExpressionContext) BitClear() antlr.TerminalNode {
+	return s.GetToken(ExprParserBitClear, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
+func (s *MultiplicativeExpressionContext) LeftShiftArithmetic() antlr.TerminalNode {
+	return s.GetToken(ExprParserLeftShiftArithmetic, 0)
+}
+
+func (s *MultiplicativeExpressionContext) RightShiftArithmetic() antlr.TerminalNode {
+	return s.GetToken(ExprParserRightShiftArithmetic, 0)
+}
+
+func (s *MultiplicativeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.EnterMultiplicativeExpression(s)
 	}
 }
 
-type RangeExpressionContext struct {
+func (s *MultiplicativeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.ExitMultiplicativeExpression(s)
+	}
+}
+
+func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ExprVisitor:
+		return t.VisitMultiplicativeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CallExpressionContext struct {
 	*ExprContext
-	op antlr.Token
+	args IArgumentsContext
 }
 
-func NewRangeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RangeExpressionContext {
-	var p = new(RangeExpressionContext)
+func NewCallExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallExpressionContext {
+	var p = new(CallExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1247,66 +1303,70 @@ func NewRangeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *RangeExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *CallExpressionContext) GetArgs() IArgumentsContext { return s.args }
 
-func (s *RangeExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *CallExpressionContext) SetArgs(v IArgumentsContext) { s.args = v }
 
-func (s *RangeExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *CallExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RangeExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *CallExpressionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IExprContext)
 }
 
-func (s *RangeExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *CallExpressionContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserOpenParen, 0)
+}
+
+func (s *CallExpressionContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserCloseParen, 0)
+}
+
+func (s *CallExpressionContext) Arguments() IArgumentsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IArgumentsContext)
 }
 
-func (s *RangeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CallExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterRangeExpression(s)
+		listenerT.EnterCallExpression(s)
 	}
 }
 
-func (s *RangeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CallExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitRangeExpression(s)
+		listenerT.ExitCallExpression(s)
 	}
 }
 
-func (s *RangeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitRangeExpression(s)
+		return t.VisitCallExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MemberIndexExpressionContext struct {
+type ParenthesizedExpressionContext struct {
 	*ExprContext
-	index IExprContext
 }
 
-func NewMemberIndexExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberIndexExpressionContext {
-	var p = new(MemberIndexExpressionContext)
+func NewParenthesizedExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenthesizedExpressionContext {
+	var p = new(ParenthesizedExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1315,29 +1375,16 @@ func NewMemberIndexExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCo
 	return p
 }
 
-func (s *MemberIndexExpressionContext) GetIndex() IExprContext { return s.index }
-
-func (s *MemberIndexExpressionContext) SetIndex(v IExprContext) { s.index = v }
-
-func (s *MemberIndexExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ParenthesizedExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MemberIndexExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
+func (s *ParenthesizedExpressionContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserOpenParen, 0)
 }
 
-func (s *MemberIndexExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *ParenthesizedExpressionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -1346,42 +1393,39 @@ func (s *MemberIndexExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *MemberIndexExpressionContext) OpenBracket() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenBracket, 0)
-}
-
-func (s *MemberIndexExpressionContext) CloseBracket() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseBracket, 0)
+func (s *ParenthesizedExpressionContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserCloseParen, 0)
 }
 
-func (s *MemberIndexExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ParenthesizedExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterMemberIndexExpression(s)
+		listenerT.EnterParenthesizedExpression(s)
 	}
 }
 
-func (s *MemberIndexExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ParenthesizedExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitMemberIndexExpression(s)
+		listenerT.ExitParenthesizedExpression(s)
 	}
 }
 
-func (s *MemberIndexExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ParenthesizedExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitMemberIndexExpression(s)
+		return t.VisitParenthesizedExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type IdentifierExpressionContext struct {
+type AdditiveExpressionContext struct {
 	*ExprContext
+	op antlr.Token
 }
 
-func NewIdentifierExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdentifierExpressionContext {
-	var p = new(IdentifierExpressionContext)
+func NewAdditiveExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExpressionContext {
+	var p = new(AdditiveExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1390,87 +1434,82 @@ func NewIdentifierExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCon
 	return p
 }
 
-func (s *IdentifierExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *AdditiveExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *AdditiveExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierExpressionContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(ExprParserIdentifier, 0)
-}
+func (s *AdditiveExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
-func (s *IdentifierExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterIdentifierExpression(s)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
 	}
-}
 
-func (s *IdentifierExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitIdentifierExpression(s)
-	}
+	return tst
 }
 
-func (s *IdentifierExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ExprVisitor:
-		return t.VisitIdentifierExpression(s)
+func (s *AdditiveExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
-}
 
-type PointerExpressionContext struct {
-	*ExprContext
+	return t.(IExprContext)
 }
 
-func NewPointerExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PointerExpressionContext {
-	var p = new(PointerExpressionContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *AdditiveExpressionContext) Plus() antlr.TerminalNode {
+	return s.GetToken(ExprParserPlus, 0)
+}
 
-	return p
+func (s *AdditiveExpressionContext) Minus() antlr.TerminalNode {
+	return s.GetToken(ExprParserMinus, 0)
 }
 
-func (s *PointerExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *AdditiveExpressionContext) BitOr() antlr.TerminalNode {
+	return s.GetToken(ExprParserBitOr, 0)
 }
 
-func (s *PointerExpressionContext) Pointer() antlr.TerminalNode {
-	return s.GetToken(ExprParserPointer, 0)
+func (s *AdditiveExpressionContext) BitXor() antlr.TerminalNode {
+	return s.GetToken(ExprParserBitXor, 0)
 }
 
-func (s *PointerExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AdditiveExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterPointerExpression(s)
+		listenerT.EnterAdditiveExpression(s)
 	}
 }
 
-func (s *PointerExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AdditiveExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitPointerExpression(s)
+		listenerT.ExitAdditiveExpression(s)
 	}
 }
 
-func (s *PointerExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitPointerExpression(s)
+		return t.VisitAdditiveExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type LogicalExpressionContext struct {
+type RelationalExpressionContext struct {
 	*ExprContext
 	op antlr.Token
 }
 
-func NewLogicalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalExpressionContext {
-	var p = new(LogicalExpressionContext)
+func NewRelationalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RelationalExpressionContext {
+	var p = new(RelationalExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1479,15 +1518,15 @@ func NewLogicalExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContex
 	return p
 }
 
-func (s *LogicalExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *RelationalExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *LogicalExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *RelationalExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *LogicalExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *RelationalExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LogicalExpressionContext) AllExpr() []IExprContext {
+func (s *RelationalExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1500,7 +1539,7 @@ func (s *LogicalExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *LogicalExpressionContext) Expr(i int) IExprContext {
+func (s *RelationalExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1510,43 +1549,51 @@ func (s *LogicalExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *LogicalExpressionContext) And() antlr.TerminalNode {
-	return s.GetToken(ExprParserAnd, 0)
+func (s *RelationalExpressionContext) LessThan() antlr.TerminalNode {
+	return s.GetToken(ExprParserLessThan, 0)
 }
 
-func (s *LogicalExpressionContext) Or() antlr.TerminalNode {
-	return s.GetToken(ExprParserOr, 0)
+func (s *RelationalExpressionContext) MoreThan() antlr.TerminalNode {
+	return s.GetToken(ExprParserMoreThan, 0)
 }
 
-func (s *LogicalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RelationalExpressionContext) LessThanEquals() antlr.TerminalNode {
+	return s.GetToken(ExprParserLessThanEquals, 0)
+}
+
+func (s *RelationalExpressionContext) GreaterThanEquals() antlr.TerminalNode {
+	return s.GetToken(ExprParserGreaterThanEquals, 0)
+}
+
+func (s *RelationalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterLogicalExpression(s)
+		listenerT.EnterRelationalExpression(s)
 	}
 }
 
-func (s *LogicalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RelationalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitLogicalExpression(s)
+		listenerT.ExitRelationalExpression(s)
 	}
 }
 
-func (s *LogicalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RelationalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitLogicalExpression(s)
+		return t.VisitRelationalExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ClosureMemberDotExpressionContext struct {
+type ContainsExpressionContext struct {
 	*ExprContext
-	name antlr.Token
+	op antlr.Token
 }
 
-func NewClosureMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ClosureMemberDotExpressionContext {
-	var p = new(ClosureMemberDotExpressionContext)
+func NewContainsExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ContainsExpressionContext {
+	var p = new(ContainsExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1555,51 +1602,71 @@ func NewClosureMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserR
 	return p
 }
 
-func (s *ClosureMemberDotExpressionContext) GetName() antlr.Token { return s.name }
+func (s *ContainsExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *ClosureMemberDotExpressionContext) SetName(v antlr.Token) { s.name = v }
+func (s *ContainsExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *ClosureMemberDotExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ContainsExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ClosureMemberDotExpressionContext) Dot() antlr.TerminalNode {
-	return s.GetToken(ExprParserDot, 0)
+func (s *ContainsExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *ClosureMemberDotExpressionContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(ExprParserIdentifier, 0)
+func (s *ContainsExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
 }
 
-func (s *ClosureMemberDotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ContainsExpressionContext) Contains() antlr.TerminalNode {
+	return s.GetToken(ExprParserContains, 0)
+}
+
+func (s *ContainsExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterClosureMemberDotExpression(s)
+		listenerT.EnterContainsExpression(s)
 	}
 }
 
-func (s *ClosureMemberDotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ContainsExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitClosureMemberDotExpression(s)
+		listenerT.ExitContainsExpression(s)
 	}
 }
 
-func (s *ClosureMemberDotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ContainsExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitClosureMemberDotExpression(s)
+		return t.VisitContainsExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type EndsWithExpressionContext struct {
+type MatchesExpressionContext struct {
 	*ExprContext
-	op antlr.Token
+	op      antlr.Token
+	pattern IExprContext
 }
 
-func NewEndsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EndsWithExpressionContext {
-	var p = new(EndsWithExpressionContext)
+func NewMatchesExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MatchesExpressionContext {
+	var p = new(MatchesExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1608,15 +1675,19 @@ func NewEndsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleConte
 	return p
 }
 
-func (s *EndsWithExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *MatchesExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *EndsWithExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *MatchesExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-func (s *EndsWithExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchesExpressionContext) GetPattern() IExprContext { return s.pattern }
+
+func (s *MatchesExpressionContext) SetPattern(v IExprContext) { s.pattern = v }
+
+func (s *MatchesExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EndsWithExpressionContext) AllExpr() []IExprContext {
+func (s *MatchesExpressionContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1629,7 +1700,7 @@ func (s *EndsWithExpressionContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *EndsWithExpressionContext) Expr(i int) IExprContext {
+func (s *MatchesExpressionContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1639,39 +1710,38 @@ func (s *EndsWithExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *EndsWithExpressionContext) EndsWith() antlr.TerminalNode {
-	return s.GetToken(ExprParserEndsWith, 0)
+func (s *MatchesExpressionContext) Matches() antlr.TerminalNode {
+	return s.GetToken(ExprParserMatches, 0)
 }
 
-func (s *EndsWithExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchesExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterEndsWithExpression(s)
+		listenerT.EnterMatchesExpression(s)
 	}
 }
 
-func (s *EndsWithExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchesExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitEndsWithExpression(s)
+		listenerT.ExitMatchesExpression(s)
 	}
 }
 
-func (s *EndsWithExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchesExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitEndsWithExpression(s)
+		return t.VisitMatchesExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type StartsWithExpressionContext struct {
+type MapLiteralExpressionContext struct {
 	*ExprContext
-	op antlr.Token
 }
 
-func NewStartsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StartsWithExpressionContext {
-	var p = new(StartsWithExpressionContext)
+func NewMapLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapLiteralExpressionContext {
+	var p = new(MapLiteralExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1680,70 +1750,48 @@ func NewStartsWithExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleCon
 	return p
 }
 
-func (s *StartsWithExpressionContext) GetOp() antlr.Token { return s.op }
-
-func (s *StartsWithExpressionContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *StartsWithExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MapLiteralExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StartsWithExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *StartsWithExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *MapLiteralExpressionContext) MapLiteral() IMapLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapLiteralContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
-}
-
-func (s *StartsWithExpressionContext) StartsWith() antlr.TerminalNode {
-	return s.GetToken(ExprParserStartsWith, 0)
+	return t.(IMapLiteralContext)
 }
 
-func (s *StartsWithExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterStartsWithExpression(s)
+		listenerT.EnterMapLiteralExpression(s)
 	}
 }
 
-func (s *StartsWithExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitStartsWithExpression(s)
+		listenerT.ExitMapLiteralExpression(s)
 	}
 }
 
-func (s *StartsWithExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapLiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitStartsWithExpression(s)
+		return t.VisitMapLiteralExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type EqualityExpressionContext struct {
+type LiteralExpressionContext struct {
 	*ExprContext
-	op antlr.Token
 }
 
-func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExpressionContext {
-	var p = new(EqualityExpressionContext)
+func NewLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExpressionContext {
+	var p = new(LiteralExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1752,73 +1800,48 @@ func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleConte
 	return p
 }
 
-func (s *EqualityExpressionContext) GetOp() antlr.Token { return s.op }
-
-func (s *EqualityExpressionContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *LiteralExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EqualityExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *EqualityExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *LiteralExpressionContext) Literal() ILiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
-}
-
-func (s *EqualityExpressionContext) Equals() antlr.TerminalNode {
-	return s.GetToken(ExprParserEquals, 0)
-}
-
-func (s *EqualityExpressionContext) NotEquals() antlr.TerminalNode {
-	return s.GetToken(ExprParserNotEquals, 0)
+	return t.(ILiteralContext)
 }
 
-func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterEqualityExpression(s)
+		listenerT.EnterLiteralExpression(s)
 	}
 }
 
-func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitEqualityExpression(s)
+		listenerT.ExitLiteralExpression(s)
 	}
 }
 
-func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitEqualityExpression(s)
+		return t.VisitLiteralExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BuiltinLiteralExpressionContext struct {
+type ArrayLiteralExpressionContext struct {
 	*ExprContext
 }
 
-func NewBuiltinLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BuiltinLiteralExpressionContext {
-	var p = new(BuiltinLiteralExpressionContext)
+func NewArrayLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayLiteralExpressionContext {
+	var p = new(ArrayLiteralExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1827,49 +1850,50 @@ func NewBuiltinLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRul
 	return p
 }
 
-func (s *BuiltinLiteralExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayLiteralExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BuiltinLiteralExpressionContext) Builtins() IBuiltinsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBuiltinsContext)(nil)).Elem(), 0)
+func (s *ArrayLiteralExpressionContext) ArrayLiteral() IArrayLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLiteralContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBuiltinsContext)
+	return t.(IArrayLiteralContext)
 }
 
-func (s *BuiltinLiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterBuiltinLiteralExpression(s)
+		listenerT.EnterArrayLiteralExpression(s)
 	}
 }
 
-func (s *BuiltinLiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitBuiltinLiteralExpression(s)
+		listenerT.ExitArrayLiteralExpression(s)
 	}
 }
 
-func (s *BuiltinLiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayLiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitBuiltinLiteralExpression(s)
+		return t.VisitArrayLiteralExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MultiplicativeExpressionContext struct {
+type MemberDotExpressionContext struct {
 	*ExprContext
-	op antlr.Token
+	op   antlr.Token
+	name antlr.Token
 }
 
-func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicativeExpressionContext {
-	var p = new(MultiplicativeExpressionContext)
+func NewMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberDotExpressionContext {
+	var p = new(MemberDotExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1878,29 +1902,20 @@ func NewMultiplicativeExpressionContext(parser antlr.Parser, ctx antlr.ParserRul
 	return p
 }
 
-func (s *MultiplicativeExpressionContext) GetOp() antlr.Token { return s.op }
-
-func (s *MultiplicativeExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *MemberDotExpressionContext) GetOp() antlr.Token { return s.op }
 
-func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *MemberDotExpressionContext) GetName() antlr.Token { return s.name }
 
-func (s *MultiplicativeExpressionContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *MemberDotExpressionContext) SetOp(v antlr.Token) { s.op = v }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
+func (s *MemberDotExpressionContext) SetName(v antlr.Token) { s.name = v }
 
-	return tst
+func (s *MemberDotExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *MultiplicativeExpressionContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *MemberDotExpressionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -1909,51 +1924,47 @@ func (s *MultiplicativeExpressionContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *MultiplicativeExpressionContext) Multiply() antlr.TerminalNode {
-	return s.GetToken(ExprParserMultiply, 0)
-}
-
-func (s *MultiplicativeExpressionContext) Exponent() antlr.TerminalNode {
-	return s.GetToken(ExprParserExponent, 0)
+func (s *MemberDotExpressionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(ExprParserIdentifier, 0)
 }
 
-func (s *MultiplicativeExpressionContext) Divide() antlr.TerminalNode {
-	return s.GetToken(ExprParserDivide, 0)
+func (s *MemberDotExpressionContext) Dot() antlr.TerminalNode {
+	return s.GetToken(ExprParserDot, 0)
 }
 
-func (s *MultiplicativeExpressionContext) Modulus() antlr.TerminalNode {
-	return s.GetToken(ExprParserModulus, 0)
+func (s *MemberDotExpressionContext) QuestionDot() antlr.TerminalNode {
+	return s.GetToken(ExprParserQuestionDot, 0)
 }
 
-func (s *MultiplicativeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MemberDotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterMultiplicativeExpression(s)
+		listenerT.EnterMemberDotExpression(s)
 	}
 }
 
-func (s *MultiplicativeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MemberDotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitMultiplicativeExpression(s)
+		listenerT.ExitMemberDotExpression(s)
 	}
 }
 
-func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MemberDotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitMultiplicativeExpression(s)
+		return t.VisitMemberDotExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type CallExpressionContext struct {
+type MemberIndexExpressionContext struct {
 	*ExprContext
-	args IArgumentsContext
+	index IExprContext
 }
 
-func NewCallExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallExpressionContext {
-	var p = new(CallExpressionContext)
+func NewMemberIndexExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberIndexExpressionContext {
+	var p = new(MemberIndexExpressionContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1962,16 +1973,29 @@ func NewCallExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *CallExpressionContext) GetArgs() IArgumentsContext { return s.args }
+func (s *MemberIndexExpressionContext) GetIndex() IExprContext { return s.index }
 
-func (s *CallExpressionContext) SetArgs(v IArgumentsContext) { s.args = v }
+func (s *MemberIndexExpressionContext) SetIndex(v IExprContext) { s.index = v }
 
-func (s *CallExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MemberIndexExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CallExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *MemberIndexExpressionContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MemberIndexExpressionContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -1980,40 +2004,171 @@ func (s *CallExpressionContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *CallExpressionContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenParen, 0)
+func (s *MemberIndexExpressionContext) OpenBracket() antlr.TerminalNode {
+	return s.GetToken(ExprParserOpenBracket, 0)
 }
 
-func (s *CallExpressionContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseParen, 0)
+func (s *MemberIndexExpressionContext) CloseBracket() antlr.TerminalNode {
+	return s.GetToken(ExprParserCloseBracket, 0)
 }
 
-func (s *CallExpressionContext) Arguments() IArgumentsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentsContext)(nil)).Elem(), 0)
+func (s *MemberIndexExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.EnterMemberIndexExpression(s)
+	}
+}
 
-	if t == nil {
-		return nil
+func (s *MemberIndexExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.ExitMemberIndexExpression(s)
 	}
+}
 
-	return t.(IArgumentsContext)
+func (s *MemberIndexExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ExprVisitor:
+		return t.VisitMemberIndexExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *CallExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+type IdentifierExpressionContext struct {
+	*ExprContext
+}
+
+func NewIdentifierExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdentifierExpressionContext {
+	var p = new(IdentifierExpressionContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IdentifierExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IdentifierExpressionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(ExprParserIdentifier, 0)
+}
+
+func (s *IdentifierExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterCallExpression(s)
+		listenerT.EnterIdentifierExpression(s)
 	}
 }
 
-func (s *CallExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitCallExpression(s)
+		listenerT.ExitIdentifierExpression(s)
 	}
 }
 
-func (s *CallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitCallExpression(s)
+		return t.VisitIdentifierExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type PointerExpressionContext struct {
+	*ExprContext
+}
+
+func NewPointerExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PointerExpressionContext {
+	var p = new(PointerExpressionContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PointerExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PointerExpressionContext) Pointer() antlr.TerminalNode {
+	return s.GetToken(ExprParserPointer, 0)
+}
+
+func (s *PointerExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.EnterPointerExpression(s)
+	}
+}
+
+func (s *PointerExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.ExitPointerExpression(s)
+	}
+}
+
+func (s *PointerExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ExprVisitor:
+		return t.VisitPointerExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ClosureMemberDotExpressionContext struct {
+	*ExprContext
+	name antlr.Token
+}
+
+func NewClosureMemberDotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ClosureMemberDotExpressionContext {
+	var p = new(ClosureMemberDotExpressionContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ClosureMemberDotExpressionContext) GetName() antlr.Token { return s.name }
+
+func (s *ClosureMemberDotExpressionContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *ClosureMemberDotExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClosureMemberDotExpressionContext) Dot() antlr.TerminalNode {
+	return s.GetToken(ExprParserDot, 0)
+}
+
+func (s *ClosureMemberDotExpressionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(ExprParserIdentifier, 0)
+}
+
+func (s *ClosureMemberDotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.EnterClosureMemberDotExpression(s)
+	}
+}
+
+func (s *ClosureMemberDotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.ExitClosureMemberDotExpression(s)
+	}
+}
+
+func (s *ClosureMemberDotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ExprVisitor:
+		return t.VisitClosureMemberDotExpression(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -2053,7 +2208,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(44)
+	p.SetState(50)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -2074,21 +2229,12 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 			localctx.(*ClosureMemberDotExpressionContext).name = _m
 		}
 
-	case ExprParserT__1, ExprParserT__2, ExprParserT__3, ExprParserT__4, ExprParserT__5, ExprParserT__6, ExprParserT__7:
-		localctx = NewBuiltinLiteralExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(32)
-			p.Builtins()
-		}
-
-	case ExprParserPlus, ExprParserMinus, ExprParserNot:
+	case ExprParserPlus, ExprParserMinus, ExprParserNot, ExprParserBitNot:
 		localctx = NewUnaryExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(33)
+			p.SetState(32)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -2096,7 +2242,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserPlus)|(1<<ExprParserMinus)|(1<<ExprParserNot))) != 0) {
+			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserPlus)|(1<<ExprParserMinus)|(1<<ExprParserNot)|(1<<ExprParserBitNot))) != 0) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
 				localctx.(*UnaryExpressionContext).op = _ri
@@ -2106,8 +2252,8 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(34)
-			p.expr(20)
+			p.SetState(33)
+			p.expr(22)
 		}
 
 	case ExprParserIdentifier:
@@ -2115,7 +2261,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(35)
+			p.SetState(34)
 			p.Match(ExprParserIdentifier)
 		}
 
@@ -2124,16 +2270,16 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(36)
+			p.SetState(35)
 			p.Match(ExprParserPointer)
 		}
 
-	case ExprParserNilLiteral, ExprParserBooleanLiteral, ExprParserIntegerLiteral, ExprParserFloatLiteral, ExprParserHexIntegerLiteral, ExprParserStringLiteral:
+	case ExprParserNilLiteral, ExprParserBooleanLiteral, ExprParserIntegerLiteral, ExprParserFloatLiteral, ExprParserHexIntegerLiteral, ExprParserOctalIntegerLiteral, ExprParserBinaryIntegerLiteral, ExprParserDurationLiteral, ExprParserStringLiteral:
 		localctx = NewLiteralExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(37)
+			p.SetState(36)
 			p.Literal()
 		}
 
@@ -2142,7 +2288,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(38)
+			p.SetState(37)
 			p.ArrayLiteral()
 		}
 
@@ -2151,7 +2297,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(39)
+			p.SetState(38)
 			p.MapLiteral()
 		}
 
@@ -2160,23 +2306,61 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(40)
+			p.SetState(39)
 			p.Match(ExprParserOpenParen)
 		}
 		{
-			p.SetState(41)
+			p.SetState(40)
 			p.expr(0)
 		}
 		{
-			p.SetState(42)
+			p.SetState(41)
 			p.Match(ExprParserCloseParen)
 		}
 
+	case ExprParserLet:
+		localctx = NewLetExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(43)
+			p.Match(ExprParserLet)
+		}
+		{
+			p.SetState(44)
+
+			var _m = p.Match(ExprParserIdentifier)
+
+			localctx.(*LetExpressionContext).name = _m
+		}
+		{
+			p.SetState(45)
+			p.Match(ExprParserAssign)
+		}
+		{
+			p.SetState(46)
+
+			var _x = p.expr(0)
+
+			localctx.(*LetExpressionContext).value = _x
+		}
+		{
+			p.SetState(47)
+			p.Match(ExprParserSemiColon)
+		}
+		{
+			p.SetState(48)
+
+			var _x = p.expr(1)
+
+			localctx.(*LetExpressionContext).body = _x
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(104)
+	p.SetState(113)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 
@@ -2186,39 +2370,39 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(102)
+			p.SetState(111)
 			p.GetErrorHandler().Sync(p)
 			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewRangeExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(46)
+				p.SetState(52)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 				}
 				{
-					p.SetState(47)
+					p.SetState(53)
 
-					var _m = p.Match(ExprParserT__0)
+					var _m = p.Match(ExprParserRange)
 
 					localctx.(*RangeExpressionContext).op = _m
 				}
 				{
-					p.SetState(48)
-					p.expr(20)
+					p.SetState(54)
+					p.expr(22)
 				}
 
 			case 2:
 				localctx = NewMultiplicativeExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(49)
+				p.SetState(55)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(50)
+					p.SetState(56)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2226,7 +2410,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserMultiply)|(1<<ExprParserExponent)|(1<<ExprParserDivide)|(1<<ExprParserModulus))) != 0) {
+					if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserMultiply)|(1<<ExprParserExponent)|(1<<ExprParserDivide)|(1<<ExprParserModulus)|(1<<ExprParserBitAnd)|(1<<ExprParserBitClear)|(1<<ExprParserRightShiftArithmetic)|(1<<ExprParserLeftShiftArithmetic))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*MultiplicativeExpressionContext).op = _ri
@@ -2236,20 +2420,20 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(51)
-					p.expr(19)
+					p.SetState(57)
+					p.expr(21)
 				}
 
 			case 3:
 				localctx = NewAdditiveExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(52)
+				p.SetState(58)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(53)
+					p.SetState(59)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2257,7 +2441,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(_la == ExprParserPlus || _la == ExprParserMinus) {
+					if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserPlus)|(1<<ExprParserMinus)|(1<<ExprParserBitOr)|(1<<ExprParserBitXor))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*AdditiveExpressionContext).op = _ri
@@ -2267,20 +2451,20 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(54)
-					p.expr(18)
+					p.SetState(60)
+					p.expr(20)
 				}
 
 			case 4:
 				localctx = NewRelationalExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(55)
+				p.SetState(61)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
 				}
 				{
-					p.SetState(56)
+					p.SetState(62)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2288,7 +2472,7 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(((_la-30)&-(0x1f+1)) == 0 && ((1<<uint((_la-30)))&((1<<(ExprParserLessThan-30))|(1<<(ExprParserMoreThan-30))|(1<<(ExprParserLessThanEquals-30))|(1<<(ExprParserGreaterThanEquals-30)))) != 0) {
+					if !(((_la-31)&-(0x1f+1)) == 0 && ((1<<uint((_la-31)))&((1<<(ExprParserLessThan-31))|(1<<(ExprParserMoreThan-31))|(1<<(ExprParserLessThanEquals-31))|(1<<(ExprParserGreaterThanEquals-31)))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*RelationalExpressionContext).op = _ri
@@ -2298,89 +2482,89 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(57)
-					p.expr(17)
+					p.SetState(63)
+					p.expr(19)
 				}
 
 			case 5:
 				localctx = NewStartsWithExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(58)
+				p.SetState(64)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
 				}
 				{
-					p.SetState(59)
+					p.SetState(65)
 
 					var _m = p.Match(ExprParserStartsWith)
 
 					localctx.(*StartsWithExpressionContext).op = _m
 				}
 				{
-					p.SetState(60)
-					p.expr(16)
+					p.SetState(66)
+					p.expr(18)
 				}
 
 			case 6:
 				localctx = NewEndsWithExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(61)
+				p.SetState(67)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
-					p.SetState(62)
+					p.SetState(68)
 
 					var _m = p.Match(ExprParserEndsWith)
 
 					localctx.(*EndsWithExpressionContext).op = _m
 				}
 				{
-					p.SetState(63)
-					p.expr(15)
+					p.SetState(69)
+					p.expr(17)
 				}
 
 			case 7:
 				localctx = NewContainsExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(64)
+				p.SetState(70)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
 				}
 				{
-					p.SetState(65)
+					p.SetState(71)
 
 					var _m = p.Match(ExprParserContains)
 
 					localctx.(*ContainsExpressionContext).op = _m
 				}
 				{
-					p.SetState(66)
-					p.expr(14)
+					p.SetState(72)
+					p.expr(16)
 				}
 
 			case 8:
 				localctx = NewMatchesExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(67)
+				p.SetState(73)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
 				}
 				{
-					p.SetState(68)
+					p.SetState(74)
 
 					var _m = p.Match(ExprParserMatches)
 
 					localctx.(*MatchesExpressionContext).op = _m
 				}
 				{
-					p.SetState(69)
+					p.SetState(75)
 
-					var _x = p.expr(13)
+					var _x = p.expr(15)
 
 					localctx.(*MatchesExpressionContext).pattern = _x
 				}
@@ -2388,13 +2572,13 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 			case 9:
 				localctx = NewInExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(70)
+				p.SetState(76)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 				}
 				{
-					p.SetState(71)
+					p.SetState(77)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2412,20 +2596,20 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(72)
-					p.expr(12)
+					p.SetState(78)
+					p.expr(14)
 				}
 
 			case 10:
 				localctx = NewEqualityExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(73)
+				p.SetState(79)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 				}
 				{
-					p.SetState(74)
+					p.SetState(80)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2443,144 +2627,178 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(75)
-					p.expr(11)
+					p.SetState(81)
+					p.expr(13)
 				}
 
 			case 11:
 				localctx = NewLogicalExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(76)
+				p.SetState(82)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 				}
 				{
-					p.SetState(77)
+					p.SetState(83)
 
 					var _m = p.Match(ExprParserAnd)
 
 					localctx.(*LogicalExpressionContext).op = _m
 				}
 				{
-					p.SetState(78)
-					p.expr(10)
+					p.SetState(84)
+					p.expr(12)
 				}
 
 			case 12:
 				localctx = NewLogicalExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(79)
+				p.SetState(85)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(80)
+					p.SetState(86)
 
 					var _m = p.Match(ExprParserOr)
 
 					localctx.(*LogicalExpressionContext).op = _m
 				}
 				{
-					p.SetState(81)
-					p.expr(9)
+					p.SetState(87)
+					p.expr(11)
 				}
 
 			case 13:
+				localctx = NewNilCoalescingExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
+				p.SetState(88)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				}
+				{
+					p.SetState(89)
+
+					var _m = p.Match(ExprParserNilCoalescing)
+
+					localctx.(*NilCoalescingExpressionContext).op = _m
+				}
+				{
+					p.SetState(90)
+					p.expr(10)
+				}
+
+			case 14:
 				localctx = NewTernaryExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(82)
+				p.SetState(91)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(83)
+					p.SetState(92)
 					p.Match(ExprParserQuestionMark)
 				}
 				{
-					p.SetState(84)
+					p.SetState(93)
 
 					var _x = p.expr(0)
 
 					localctx.(*TernaryExpressionContext).e1 = _x
 				}
 				{
-					p.SetState(85)
+					p.SetState(94)
 					p.Match(ExprParserColon)
 				}
 				{
-					p.SetState(86)
+					p.SetState(95)
 
-					var _x = p.expr(8)
+					var _x = p.expr(9)
 
 					localctx.(*TernaryExpressionContext).e2 = _x
 				}
 
-			case 14:
+			case 15:
 				localctx = NewMemberIndexExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(88)
+				p.SetState(97)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 24)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 24)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 25)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 25)", ""))
 				}
 				{
-					p.SetState(89)
+					p.SetState(98)
 					p.Match(ExprParserOpenBracket)
 				}
 				{
-					p.SetState(90)
+					p.SetState(99)
 
 					var _x = p.expr(0)
 
 					localctx.(*MemberIndexExpressionContext).index = _x
 				}
 				{
-					p.SetState(91)
+					p.SetState(100)
 					p.Match(ExprParserCloseBracket)
 				}
 
-			case 15:
+			case 16:
 				localctx = NewMemberDotExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(93)
+				p.SetState(102)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 24)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 24)", ""))
 				}
 				{
-					p.SetState(94)
-					p.Match(ExprParserDot)
+					p.SetState(103)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*MemberDotExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == ExprParserQuestionDot || _la == ExprParserDot) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*MemberDotExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
 				}
 				{
-					p.SetState(95)
+					p.SetState(104)
 
 					var _m = p.Match(ExprParserIdentifier)
 
 					localctx.(*MemberDotExpressionContext).name = _m
 				}
 
-			case 16:
+			case 17:
 				localctx = NewCallExpressionContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, ExprParserRULE_expr)
-				p.SetState(96)
+				p.SetState(105)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
 				}
 				{
-					p.SetState(97)
+					p.SetState(106)
 					p.Match(ExprParserOpenParen)
 				}
-				p.SetState(99)
+				p.SetState(108)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
-				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserT__1)|(1<<ExprParserT__2)|(1<<ExprParserT__3)|(1<<ExprParserT__4)|(1<<ExprParserT__5)|(1<<ExprParserT__6)|(1<<ExprParserT__7)|(1<<ExprParserOpenBracket)|(1<<ExprParserOpenParen)|(1<<ExprParserOpenBrace)|(1<<ExprParserDot)|(1<<ExprParserPlus)|(1<<ExprParserMinus)|(1<<ExprParserNot))) != 0) || (((_la-36)&-(0x1f+1)) == 0 && ((1<<uint((_la-36)))&((1<<(ExprParserPointer-36))|(1<<(ExprParserNilLiteral-36))|(1<<(ExprParserBooleanLiteral-36))|(1<<(ExprParserIntegerLiteral-36))|(1<<(ExprParserFloatLiteral-36))|(1<<(ExprParserHexIntegerLiteral-36))|(1<<(ExprParserIdentifier-36))|(1<<(ExprParserStringLiteral-36)))) != 0) {
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ExprParserOpenBracket)|(1<<ExprParserOpenParen)|(1<<ExprParserOpenBrace)|(1<<ExprParserDot)|(1<<ExprParserPlus)|(1<<ExprParserMinus)|(1<<ExprParserNot)|(1<<ExprParserBitNot))) != 0) || (((_la-37)&-(0x1f+1)) == 0 && ((1<<uint((_la-37)))&((1<<(ExprParserPointer-37))|(1<<(ExprParserLet-37))|(1<<(ExprParserNilLiteral-37))|(1<<(ExprParserBooleanLiteral-37))|(1<<(ExprParserIntegerLiteral-37))|(1<<(ExprParserFloatLiteral-37))|(1<<(ExprParserHexIntegerLiteral-37))|(1<<(ExprParserOctalIntegerLiteral-37))|(1<<(ExprParserBinaryIntegerLiteral-37))|(1<<(ExprParserDurationLiteral-37))|(1<<(ExprParserIdentifier-37))|(1<<(ExprParserStringLiteral-37)))) != 0) {
 					{
-						p.SetState(98)
+						p.SetState(107)
 
 						var _x = p.Arguments()
 
@@ -2589,14 +2807,14 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 
 				}
 				{
-					p.SetState(101)
+					p.SetState(110)
 					p.Match(ExprParserCloseParen)
 				}
 
 			}
 
 		}
-		p.SetState(106)
+		p.SetState(115)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 	}
@@ -2604,209 +2822,274 @@ func (p *ExprParser) expr(_p int) (localctx IExprContext) {
 	return localctx
 }
 
-// IBuiltinsContext is an interface to support dynamic dispatch.
-type IBuiltinsContext interface {
+// IArgumentsContext is an interface to support dynamic dispatch.
+type IArgumentsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBuiltinsContext differentiates from other interfaces.
-	IsBuiltinsContext()
+	// Get_argument returns the _argument rule contexts.
+	Get_argument() IArgumentContext
+
+	// Set_argument sets the _argument rule contexts.
+	Set_argument(IArgumentContext)
+
+	// GetList returns the list rule context list.
+	GetList() []IArgumentContext
+
+	// SetList sets the list rule context list.
+	SetList([]IArgumentContext)
+
+	// IsArgumentsContext differentiates from other interfaces.
+	IsArgumentsContext()
 }
 
-type BuiltinsContext struct {
+type ArgumentsContext struct {
 	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	parser    antlr.Parser
+	_argument IArgumentContext
+	list      []IArgumentContext
 }
 
-func NewEmptyBuiltinsContext() *BuiltinsContext {
-	var p = new(BuiltinsContext)
+func NewEmptyArgumentsContext() *ArgumentsContext {
+	var p = new(ArgumentsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = ExprParserRULE_builtins
+	p.RuleIndex = ExprParserRULE_arguments
 	return p
 }
 
-func (*BuiltinsContext) IsBuiltinsContext() {}
+func (*ArgumentsContext) IsArgumentsContext() {}
 
-func NewBuiltinsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BuiltinsContext {
-	var p = new(BuiltinsContext)
+func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
+	var p = new(ArgumentsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ExprParserRULE_builtins
+	p.RuleIndex = ExprParserRULE_arguments
 
 	return p
 }
 
-func (s *BuiltinsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BuiltinsContext) CopyFrom(ctx *BuiltinsContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
+func (s *ArgumentsContext) Get_argument() IArgumentContext { return s._argument }
 
-func (s *BuiltinsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ArgumentsContext) Set_argument(v IArgumentContext) { s._argument = v }
 
-func (s *BuiltinsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *ArgumentsContext) GetList() []IArgumentContext { return s.list }
 
-type LenBuiltinExpressionContext struct {
-	*BuiltinsContext
-	e IExprContext
-}
+func (s *ArgumentsContext) SetList(v []IArgumentContext) { s.list = v }
 
-func NewLenBuiltinExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LenBuiltinExpressionContext {
-	var p = new(LenBuiltinExpressionContext)
+func (s *ArgumentsContext) AllArgument() []IArgumentContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IArgumentContext)(nil)).Elem())
+	var tst = make([]IArgumentContext, len(ts))
 
-	p.BuiltinsContext = NewEmptyBuiltinsContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*BuiltinsContext))
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IArgumentContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *LenBuiltinExpressionContext) GetE() IExprContext { return s.e }
+func (s *ArgumentsContext) Argument(i int) IArgumentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentContext)(nil)).Elem(), i)
 
-func (s *LenBuiltinExpressionContext) SetE(v IExprContext) { s.e = v }
+	if t == nil {
+		return nil
+	}
 
-func (s *LenBuiltinExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IArgumentContext)
 }
 
-func (s *LenBuiltinExpressionContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenParen, 0)
+func (s *ArgumentsContext) AllComma() []antlr.TerminalNode {
+	return s.GetTokens(ExprParserComma)
 }
 
-func (s *LenBuiltinExpressionContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseParen, 0)
+func (s *ArgumentsContext) Comma(i int) antlr.TerminalNode {
+	return s.GetToken(ExprParserComma, i)
 }
 
-func (s *LenBuiltinExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IExprContext)
+func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LenBuiltinExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterLenBuiltinExpression(s)
+		listenerT.EnterArguments(s)
 	}
 }
 
-func (s *LenBuiltinExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitLenBuiltinExpression(s)
+		listenerT.ExitArguments(s)
 	}
 }
 
-func (s *LenBuiltinExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitLenBuiltinExpression(s)
+		return t.VisitArguments(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BuiltinExpressionContext struct {
-	*BuiltinsContext
-	name antlr.Token
-	e    IExprContext
-	c    IClosureContext
-}
+func (p *ExprParser) Arguments() (localctx IArgumentsContext) {
+	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, ExprParserRULE_arguments)
+	var _la int
 
-func NewBuiltinExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BuiltinExpressionContext {
-	var p = new(BuiltinExpressionContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.BuiltinsContext = NewEmptyBuiltinsContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*BuiltinsContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(116)
 
-func (s *BuiltinExpressionContext) GetName() antlr.Token { return s.name }
+		var _x = p.Argument()
 
-func (s *BuiltinExpressionContext) SetName(v antlr.Token) { s.name = v }
+		localctx.(*ArgumentsContext)._argument = _x
+	}
+	localctx.(*ArgumentsContext).list = append(localctx.(*ArgumentsContext).list, localctx.(*ArgumentsContext)._argument)
+	p.SetState(121)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *BuiltinExpressionContext) GetE() IExprContext { return s.e }
+	for _la == ExprParserComma {
+		{
+			p.SetState(117)
+			p.Match(ExprParserComma)
+		}
+		{
+			p.SetState(118)
 
-func (s *BuiltinExpressionContext) GetC() IClosureContext { return s.c }
+			var _x = p.Argument()
 
-func (s *BuiltinExpressionContext) SetE(v IExprContext) { s.e = v }
+			localctx.(*ArgumentsContext)._argument = _x
+		}
+		localctx.(*ArgumentsContext).list = append(localctx.(*ArgumentsContext).list, localctx.(*ArgumentsContext)._argument)
 
-func (s *BuiltinExpressionContext) SetC(v IClosureContext) { s.c = v }
+		p.SetState(123)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 
-func (s *BuiltinExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return localctx
 }
 
-func (s *BuiltinExpressionContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenParen, 0)
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
 }
 
-func (s *BuiltinExpressionContext) Comma() antlr.TerminalNode {
-	return s.GetToken(ExprParserComma, 0)
+type ArgumentContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *BuiltinExpressionContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseParen, 0)
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = ExprParserRULE_argument
+	return p
 }
 
-func (s *BuiltinExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ExprParserRULE_argument
+
+	return p
+}
+
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) Closure() IClosureContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClosureContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IClosureContext)
 }
 
-func (s *BuiltinExpressionContext) Closure() IClosureContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClosureContext)(nil)).Elem(), 0)
+func (s *ArgumentContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IClosureContext)
+	return t.(IExprContext)
+}
+
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *BuiltinExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterBuiltinExpression(s)
+		listenerT.EnterArgument(s)
 	}
 }
 
-func (s *BuiltinExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitBuiltinExpression(s)
+		listenerT.ExitArgument(s)
 	}
 }
 
-func (s *BuiltinExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitBuiltinExpression(s)
+		return t.VisitArgument(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ExprParser) Builtins() (localctx IBuiltinsContext) {
-	localctx = NewBuiltinsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, ExprParserRULE_builtins)
+func (p *ExprParser) Argument() (localctx IArgumentContext) {
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, ExprParserRULE_argument)
 
 	defer func() {
 		p.ExitRule()
@@ -2824,257 +3107,23 @@ func (p *ExprParser) Builtins() (localctx IBuiltinsContext) {
 		}
 	}()
 
-	p.SetState(154)
+	p.SetState(126)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case ExprParserT__1:
-		localctx = NewLenBuiltinExpressionContext(p, localctx)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(107)
-			p.Match(ExprParserT__1)
-		}
-		{
-			p.SetState(108)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(109)
-
-			var _x = p.expr(0)
-
-			localctx.(*LenBuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(110)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__2:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(112)
-
-			var _m = p.Match(ExprParserT__2)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(113)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(114)
-
-			var _x = p.expr(0)
-
-			localctx.(*BuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(115)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(116)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
-		{
-			p.SetState(117)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__3:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(119)
-
-			var _m = p.Match(ExprParserT__3)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(120)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(121)
-
-			var _x = p.expr(0)
-
-			localctx.(*BuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(122)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(123)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
 		{
 			p.SetState(124)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__4:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(126)
-
-			var _m = p.Match(ExprParserT__4)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(127)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(128)
-
-			var _x = p.expr(0)
-
-			localctx.(*BuiltinExpressionContext).e = _x
+			p.Closure()
 		}
-		{
-			p.SetState(129)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(130)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
-		{
-			p.SetState(131)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__5:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(133)
-
-			var _m = p.Match(ExprParserT__5)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(134)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(135)
-
-			var _x = p.expr(0)
-
-			localctx.(*BuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(136)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(137)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
-		{
-			p.SetState(138)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__6:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(140)
-
-			var _m = p.Match(ExprParserT__6)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(141)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(142)
-
-			var _x = p.expr(0)
 
-			localctx.(*BuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(143)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(144)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
-		{
-			p.SetState(145)
-			p.Match(ExprParserCloseParen)
-		}
-
-	case ExprParserT__7:
-		localctx = NewBuiltinExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(147)
-
-			var _m = p.Match(ExprParserT__7)
-
-			localctx.(*BuiltinExpressionContext).name = _m
-		}
-		{
-			p.SetState(148)
-			p.Match(ExprParserOpenParen)
-		}
-		{
-			p.SetState(149)
-
-			var _x = p.expr(0)
-
-			localctx.(*BuiltinExpressionContext).e = _x
-		}
-		{
-			p.SetState(150)
-			p.Match(ExprParserComma)
-		}
-		{
-			p.SetState(151)
-
-			var _x = p.Closure()
-
-			localctx.(*BuiltinExpressionContext).c = _x
-		}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(152)
-			p.Match(ExprParserCloseParen)
+			p.SetState(125)
+			p.expr(0)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -3106,209 +3155,73 @@ func NewEmptyClosureContext() *ClosureContext {
 func (*ClosureContext) IsClosureContext() {}
 
 func NewClosureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClosureContext {
-	var p = new(ClosureContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = ExprParserRULE_closure
-
-	return p
-}
-
-func (s *ClosureContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ClosureContext) CopyFrom(ctx *ClosureContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *ClosureContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ClosureContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type ClosureExpressionContext struct {
-	*ClosureContext
-	body IExprContext
-}
-
-func NewClosureExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ClosureExpressionContext {
-	var p = new(ClosureExpressionContext)
-
-	p.ClosureContext = NewEmptyClosureContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ClosureContext))
-
-	return p
-}
-
-func (s *ClosureExpressionContext) GetBody() IExprContext { return s.body }
-
-func (s *ClosureExpressionContext) SetBody(v IExprContext) { s.body = v }
-
-func (s *ClosureExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ClosureExpressionContext) OpenBrace() antlr.TerminalNode {
-	return s.GetToken(ExprParserOpenBrace, 0)
-}
-
-func (s *ClosureExpressionContext) CloseBrace() antlr.TerminalNode {
-	return s.GetToken(ExprParserCloseBrace, 0)
-}
-
-func (s *ClosureExpressionContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *ClosureExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterClosureExpression(s)
-	}
-}
-
-func (s *ClosureExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitClosureExpression(s)
-	}
-}
-
-func (s *ClosureExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ExprVisitor:
-		return t.VisitClosureExpression(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *ExprParser) Closure() (localctx IClosureContext) {
-	localctx = NewClosureContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, ExprParserRULE_closure)
-
-	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	localctx = NewClosureExpressionContext(p, localctx)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(156)
-		p.Match(ExprParserOpenBrace)
-	}
-	{
-		p.SetState(157)
-
-		var _x = p.expr(0)
-
-		localctx.(*ClosureExpressionContext).body = _x
-	}
-	{
-		p.SetState(158)
-		p.Match(ExprParserCloseBrace)
-	}
-
-	return localctx
-}
-
-// IArgumentsContext is an interface to support dynamic dispatch.
-type IArgumentsContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Get_expr returns the _expr rule contexts.
-	Get_expr() IExprContext
-
-	// Set_expr sets the _expr rule contexts.
-	Set_expr(IExprContext)
+	var p = new(ClosureContext)
 
-	// GetList returns the list rule context list.
-	GetList() []IExprContext
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	// SetList sets the list rule context list.
-	SetList([]IExprContext)
+	p.parser = parser
+	p.RuleIndex = ExprParserRULE_closure
 
-	// IsArgumentsContext differentiates from other interfaces.
-	IsArgumentsContext()
+	return p
 }
 
-type ArgumentsContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-	_expr  IExprContext
-	list   []IExprContext
+func (s *ClosureContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ClosureContext) CopyFrom(ctx *ClosureContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
 }
 
-func NewEmptyArgumentsContext() *ArgumentsContext {
-	var p = new(ArgumentsContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = ExprParserRULE_arguments
-	return p
+func (s *ClosureContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (*ArgumentsContext) IsArgumentsContext() {}
+func (s *ClosureContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
-	var p = new(ArgumentsContext)
+type ClosureExpressionContext struct {
+	*ClosureContext
+	body        IExprContext
+	_Identifier antlr.Token
+	params      []antlr.Token
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func NewClosureExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ClosureExpressionContext {
+	var p = new(ClosureExpressionContext)
 
+	p.ClosureContext = NewEmptyClosureContext()
 	p.parser = parser
-	p.RuleIndex = ExprParserRULE_arguments
+	p.CopyFrom(ctx.(*ClosureContext))
 
 	return p
 }
 
-func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ClosureExpressionContext) Get_Identifier() antlr.Token { return s._Identifier }
 
-func (s *ArgumentsContext) Get_expr() IExprContext { return s._expr }
+func (s *ClosureExpressionContext) Set_Identifier(v antlr.Token) { s._Identifier = v }
 
-func (s *ArgumentsContext) Set_expr(v IExprContext) { s._expr = v }
+func (s *ClosureExpressionContext) GetParams() []antlr.Token { return s.params }
 
-func (s *ArgumentsContext) GetList() []IExprContext { return s.list }
+func (s *ClosureExpressionContext) SetParams(v []antlr.Token) { s.params = v }
 
-func (s *ArgumentsContext) SetList(v []IExprContext) { s.list = v }
+func (s *ClosureExpressionContext) GetBody() IExprContext { return s.body }
 
-func (s *ArgumentsContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *ClosureExpressionContext) SetBody(v IExprContext) { s.body = v }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
+func (s *ClosureExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return tst
+func (s *ClosureExpressionContext) OpenBrace() antlr.TerminalNode {
+	return s.GetToken(ExprParserOpenBrace, 0)
 }
 
-func (s *ArgumentsContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *ClosureExpressionContext) CloseBrace() antlr.TerminalNode {
+	return s.GetToken(ExprParserCloseBrace, 0)
+}
+
+func (s *ClosureExpressionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -3317,47 +3230,55 @@ func (s *ArgumentsContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ArgumentsContext) AllComma() []antlr.TerminalNode {
-	return s.GetTokens(ExprParserComma)
+func (s *ClosureExpressionContext) Arrow() antlr.TerminalNode {
+	return s.GetToken(ExprParserArrow, 0)
 }
 
-func (s *ArgumentsContext) Comma(i int) antlr.TerminalNode {
-	return s.GetToken(ExprParserComma, i)
+func (s *ClosureExpressionContext) AllIdentifier() []antlr.TerminalNode {
+	return s.GetTokens(ExprParserIdentifier)
 }
 
-func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ClosureExpressionContext) Identifier(i int) antlr.TerminalNode {
+	return s.GetToken(ExprParserIdentifier, i)
 }
 
-func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *ClosureExpressionContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserOpenParen, 0)
 }
 
-func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ClosureExpressionContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(ExprParserCloseParen, 0)
+}
+
+func (s *ClosureExpressionContext) Comma() antlr.TerminalNode {
+	return s.GetToken(ExprParserComma, 0)
+}
+
+func (s *ClosureExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.EnterArguments(s)
+		listenerT.EnterClosureExpression(s)
 	}
 }
 
-func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ClosureExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ExprListener); ok {
-		listenerT.ExitArguments(s)
+		listenerT.ExitClosureExpression(s)
 	}
 }
 
-func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ClosureExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ExprVisitor:
-		return t.VisitArguments(s)
+		return t.VisitClosureExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ExprParser) Arguments() (localctx IArgumentsContext) {
-	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, ExprParserRULE_arguments)
+func (p *ExprParser) Closure() (localctx IClosureContext) {
+	localctx = NewClosureContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, ExprParserRULE_closure)
 	var _la int
 
 	defer func() {
@@ -3376,36 +3297,104 @@ func (p *ExprParser) Arguments() (localctx IArgumentsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(160)
+	p.SetState(144)
+	p.GetErrorHandler().Sync(p)
 
-		var _x = p.expr(0)
+	switch p.GetTokenStream().LA(1) {
+	case ExprParserOpenBrace:
+		localctx = NewClosureExpressionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(128)
+			p.Match(ExprParserOpenBrace)
+		}
+		{
+			p.SetState(129)
 
-		localctx.(*ArgumentsContext)._expr = _x
-	}
-	localctx.(*ArgumentsContext).list = append(localctx.(*ArgumentsContext).list, localctx.(*ArgumentsContext)._expr)
-	p.SetState(165)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+			var _x = p.expr(0)
 
-	for _la == ExprParserComma {
+			localctx.(*ClosureExpressionContext).body = _x
+		}
 		{
-			p.SetState(161)
-			p.Match(ExprParserComma)
+			p.SetState(130)
+			p.Match(ExprParserCloseBrace)
+		}
+
+	case ExprParserIdentifier:
+		localctx = NewClosureExpressionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(132)
+
+			var _m = p.Match(ExprParserIdentifier)
+
+			localctx.(*ClosureExpressionContext)._Identifier = _m
+		}
+		localctx.(*ClosureExpressionContext).params = append(localctx.(*ClosureExpressionContext).params, localctx.(*ClosureExpressionContext)._Identifier)
+		{
+			p.SetState(133)
+			p.Match(ExprParserArrow)
 		}
 		{
-			p.SetState(162)
+			p.SetState(134)
 
 			var _x = p.expr(0)
 
-			localctx.(*ArgumentsContext)._expr = _x
+			localctx.(*ClosureExpressionContext).body = _x
+		}
+
+	case ExprParserOpenParen:
+		localctx = NewClosureExpressionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(135)
+			p.Match(ExprParserOpenParen)
 		}
-		localctx.(*ArgumentsContext).list = append(localctx.(*ArgumentsContext).list, localctx.(*ArgumentsContext)._expr)
+		{
+			p.SetState(136)
+
+			var _m = p.Match(ExprParserIdentifier)
 
-		p.SetState(167)
+			localctx.(*ClosureExpressionContext)._Identifier = _m
+		}
+		localctx.(*ClosureExpressionContext).params = append(localctx.(*ClosureExpressionContext).params, localctx.(*ClosureExpressionContext)._Identifier)
+		p.SetState(139)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
+
+		if _la == ExprParserComma {
+			{
+				p.SetState(137)
+				p.Match(ExprParserComma)
+			}
+			{
+				p.SetState(138)
+
+				var _m = p.Match(ExprParserIdentifier)
+
+				localctx.(*ClosureExpressionContext)._Identifier = _m
+			}
+			localctx.(*ClosureExpressionContext).params = append(localctx.(*ClosureExpressionContext).params, localctx.(*ClosureExpressionContext)._Identifier)
+
+		}
+		{
+			p.SetState(141)
+			p.Match(ExprParserCloseParen)
+		}
+		{
+			p.SetState(142)
+			p.Match(ExprParserArrow)
+		}
+		{
+			p.SetState(143)
+
+			var _x = p.expr(0)
+
+			localctx.(*ClosureExpressionContext).body = _x
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -3563,46 +3552,46 @@ func (p *ExprParser) ArrayLiteral() (localctx IArrayLiteralContext) {
 
 	var _alt int
 
-	p.SetState(184)
+	p.SetState(162)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(168)
+			p.SetState(146)
 			p.Match(ExprParserOpenBracket)
 		}
 		{
-			p.SetState(169)
+			p.SetState(147)
 			p.Match(ExprParserCloseBracket)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(170)
+			p.SetState(148)
 			p.Match(ExprParserOpenBracket)
 		}
 		{
-			p.SetState(171)
+			p.SetState(149)
 
 			var _x = p.expr(0)
 
 			localctx.(*ArrayLiteralContext)._expr = _x
 		}
 		localctx.(*ArrayLiteralContext).list = append(localctx.(*ArrayLiteralContext).list, localctx.(*ArrayLiteralContext)._expr)
-		p.SetState(176)
+		p.SetState(154)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(172)
+					p.SetState(150)
 					p.Match(ExprParserComma)
 				}
 				{
-					p.SetState(173)
+					p.SetState(151)
 
 					var _x = p.expr(0)
 
@@ -3611,23 +3600,23 @@ func (p *ExprParser) ArrayLiteral() (localctx IArrayLiteralContext) {
 				localctx.(*ArrayLiteralContext).list = append(localctx.(*ArrayLiteralContext).list, localctx.(*ArrayLiteralContext)._expr)
 
 			}
-			p.SetState(178)
+			p.SetState(156)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 		}
-		p.SetState(180)
+		p.SetState(158)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == ExprParserComma {
 			{
-				p.SetState(179)
+				p.SetState(157)
 				p.Match(ExprParserComma)
 			}
 
 		}
 		{
-			p.SetState(182)
+			p.SetState(160)
 			p.Match(ExprParserCloseBracket)
 		}
 
@@ -3758,46 +3747,46 @@ func (p *ExprParser) MapLiteral() (localctx IMapLiteralContext) {
 		}
 	}()
 
-	p.SetState(195)
+	p.SetState(173)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
+			p.SetState(164)
 			p.Match(ExprParserOpenBrace)
 		}
 		{
-			p.SetState(187)
+			p.SetState(165)
 			p.Match(ExprParserCloseBrace)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(188)
+			p.SetState(166)
 			p.Match(ExprParserOpenBrace)
 		}
 		{
-			p.SetState(189)
+			p.SetState(167)
 
 			var _x = p.PropertyNameAndValueList()
 
 			localctx.(*MapLiteralContext).e = _x
 		}
-		p.SetState(191)
+		p.SetState(169)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == ExprParserComma {
 			{
-				p.SetState(190)
+				p.SetState(168)
 				p.Match(ExprParserComma)
 			}
 
 		}
 		{
-			p.SetState(193)
+			p.SetState(171)
 			p.Match(ExprParserCloseBrace)
 		}
 
@@ -3955,25 +3944,25 @@ func (p *ExprParser) PropertyNameAndValueList() (localctx IPropertyNameAndValueL
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(175)
 
 		var _x = p.PropertyAssignment()
 
 		localctx.(*PropertyNameAndValueListContext)._propertyAssignment = _x
 	}
 	localctx.(*PropertyNameAndValueListContext).list = append(localctx.(*PropertyNameAndValueListContext).list, localctx.(*PropertyNameAndValueListContext)._propertyAssignment)
-	p.SetState(202)
+	p.SetState(180)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(198)
+				p.SetState(176)
 				p.Match(ExprParserComma)
 			}
 			{
-				p.SetState(199)
+				p.SetState(177)
 
 				var _x = p.PropertyAssignment()
 
@@ -3982,9 +3971,9 @@ func (p *ExprParser) PropertyNameAndValueList() (localctx IPropertyNameAndValueL
 			localctx.(*PropertyNameAndValueListContext).list = append(localctx.(*PropertyNameAndValueListContext).list, localctx.(*PropertyNameAndValueListContext)._propertyAssignment)
 
 		}
-		p.SetState(204)
+		p.SetState(182)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -4126,18 +4115,18 @@ func (p *ExprParser) PropertyAssignment() (localctx IPropertyAssignmentContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(205)
+		p.SetState(183)
 
 		var _x = p.PropertyName()
 
 		localctx.(*PropertyAssignmentContext).name = _x
 	}
 	{
-		p.SetState(206)
+		p.SetState(184)
 		p.Match(ExprParserColon)
 	}
 	{
-		p.SetState(207)
+		p.SetState(185)
 
 		var _x = p.expr(0)
 
@@ -4246,7 +4235,7 @@ func (p *ExprParser) PropertyName() (localctx IPropertyNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(209)
+		p.SetState(187)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == ExprParserIdentifier || _la == ExprParserStringLiteral) {
@@ -4542,6 +4531,50 @@ func (s *IntegerExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interf
 	}
 }
 
+type DurationExpressionContext struct {
+	*LiteralContext
+}
+
+func NewDurationExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DurationExpressionContext {
+	var p = new(DurationExpressionContext)
+
+	p.LiteralContext = NewEmptyLiteralContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*LiteralContext))
+
+	return p
+}
+
+func (s *DurationExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DurationExpressionContext) DurationLiteral() antlr.TerminalNode {
+	return s.GetToken(ExprParserDurationLiteral, 0)
+}
+
+func (s *DurationExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.EnterDurationExpression(s)
+	}
+}
+
+func (s *DurationExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ExprListener); ok {
+		listenerT.ExitDurationExpression(s)
+	}
+}
+
+func (s *DurationExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ExprVisitor:
+		return t.VisitDurationExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 func (p *ExprParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 20, ExprParserRULE_literal)
@@ -4562,7 +4595,7 @@ func (p *ExprParser) Literal() (localctx ILiteralContext) {
 		}
 	}()
 
-	p.SetState(216)
+	p.SetState(195)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -4570,7 +4603,7 @@ func (p *ExprParser) Literal() (localctx ILiteralContext) {
 		localctx = NewNilExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(211)
+			p.SetState(189)
 			p.Match(ExprParserNilLiteral)
 		}
 
@@ -4578,7 +4611,7 @@ func (p *ExprParser) Literal() (localctx ILiteralContext) {
 		localctx = NewBooleanExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(212)
+			p.SetState(190)
 			p.Match(ExprParserBooleanLiteral)
 		}
 
@@ -4586,15 +4619,15 @@ func (p *ExprParser) Literal() (localctx ILiteralContext) {
 		localctx = NewStringLiteralExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(213)
+			p.SetState(191)
 			p.StringLiteral()
 		}
 
-	case ExprParserIntegerLiteral, ExprParserHexIntegerLiteral:
+	case ExprParserIntegerLiteral, ExprParserHexIntegerLiteral, ExprParserOctalIntegerLiteral, ExprParserBinaryIntegerLiteral:
 		localctx = NewIntegerExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(214)
+			p.SetState(192)
 			p.IntegerLiteral()
 		}
 
@@ -4602,10 +4635,18 @@ func (p *ExprParser) Literal() (localctx ILiteralContext) {
 		localctx = NewFloatExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(215)
+			p.SetState(193)
 			p.Match(ExprParserFloatLiteral)
 		}
 
+	case ExprParserDurationLiteral:
+		localctx = NewDurationExpressionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(194)
+			p.Match(ExprParserDurationLiteral)
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
@@ -4707,7 +4748,7 @@ func (p *ExprParser) StringLiteral() (localctx IStringLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(218)
+		p.SetState(197)
 		p.Match(ExprParserStringLiteral)
 	}
 
@@ -4760,6 +4801,14 @@ func (s *IntegerLiteralContext) HexIntegerLiteral() antlr.TerminalNode {
 	return s.GetToken(ExprParserHexIntegerLiteral, 0)
 }
 
+func (s *IntegerLiteralContext) OctalIntegerLiteral() antlr.TerminalNode {
+	return s.GetToken(ExprParserOctalIntegerLiteral, 0)
+}
+
+func (s *IntegerLiteralContext) BinaryIntegerLiteral() antlr.TerminalNode {
+	return s.GetToken(ExprParserBinaryIntegerLiteral, 0)
+}
+
 func (s *IntegerLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4813,10 +4862,10 @@ func (p *ExprParser) IntegerLiteral() (localctx IIntegerLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
+		p.SetState(199)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == ExprParserIntegerLiteral || _la == ExprParserHexIntegerLiteral) {
+		if !(((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(ExprParserIntegerLiteral-49))|(1<<(ExprParserHexIntegerLiteral-49))|(1<<(ExprParserOctalIntegerLiteral-49))|(1<<(ExprParserBinaryIntegerLiteral-49)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -4844,52 +4893,55 @@ func (p *ExprParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *ExprParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 19)
+		return p.Precpred(p.GetParserRuleContext(), 21)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 18)
+		return p.Precpred(p.GetParserRuleContext(), 20)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 17)
+		return p.Precpred(p.GetParserRuleContext(), 19)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 16)
+		return p.Precpred(p.GetParserRuleContext(), 18)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 15)
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 14)
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 13)
+		return p.Precpred(p.GetParserRuleContext(), 15)
 
 	case 7:
-		return p.Precpred(p.GetParserRuleContext(), 12)
+		return p.Precpred(p.GetParserRuleContext(), 14)
 
 	case 8:
-		return p.Precpred(p.GetParserRuleContext(), 11)
+		return p.Precpred(p.GetParserRuleContext(), 13)
 
 	case 9:
-		return p.Precpred(p.GetParserRuleContext(), 10)
+		return p.Precpred(p.GetParserRuleContext(), 12)
 
 	case 10:
-		return p.Precpred(p.GetParserRuleContext(), 9)
+		return p.Precpred(p.GetParserRuleContext(), 11)
 
 	case 11:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 12:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 13:
-		return p.Precpred(p.GetParserRuleContext(), 24)
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	case 14:
-		return p.Precpred(p.GetParserRuleContext(), 23)
+		return p.Precpred(p.GetParserRuleContext(), 25)
 
 	case 15:
-		return p.Precpred(p.GetParserRuleContext(), 21)
+		return p.Precpred(p.GetParserRuleContext(), 24)
+
+	case 16:
+		return p.Precpred(p.GetParserRuleContext(), 23)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -10,6 +10,42 @@ type ExprVisitor interface {
 	// Visit a parse tree produced by ExprParser#start.
 	VisitStart(ctx *StartContext) interface{}
 
+	// Visit a parse tree produced by ExprParser#TernaryExpression.
+	VisitTernaryExpression(ctx *TernaryExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#NilCoalescingExpression.
+	VisitNilCoalescingExpression(ctx *NilCoalescingExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#InExpression.
+	VisitInExpression(ctx *InExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#UnaryExpression.
+	VisitUnaryExpression(ctx *UnaryExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#RangeExpression.
+	VisitRangeExpression(ctx *RangeExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#LogicalExpression.
+	VisitLogicalExpression(ctx *LogicalExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#LetExpression.
+	VisitLetExpression(ctx *LetExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#EndsWithExpression.
+	VisitEndsWithExpression(ctx *EndsWithExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#StartsWithExpression.
+	VisitStartsWithExpression(ctx *StartsWithExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#EqualityExpression.
+	VisitEqualityExpression(ctx *EqualityExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#MultiplicativeExpression.
+	VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{}
+
+	// Visit a parse tree produced by ExprParser#CallExpression.
+	VisitCallExpression(ctx *CallExpressionContext) interface{}
+
 	// Visit a parse tree produced by ExprParser#ParenthesizedExpression.
 	VisitParenthesizedExpression(ctx *ParenthesizedExpressionContext) interface{}
 
@@ -19,9 +55,6 @@ type ExprVisitor interface {
 	// Visit a parse tree produced by ExprParser#RelationalExpression.
 	VisitRelationalExpression(ctx *RelationalExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#TernaryExpression.
-	VisitTernaryExpression(ctx *TernaryExpressionContext) interface{}
-
 	// Visit a parse tree produced by ExprParser#ContainsExpression.
 	VisitContainsExpression(ctx *ContainsExpressionContext) interface{}
 
@@ -34,21 +67,12 @@ type ExprVisitor interface {
 	// Visit a parse tree produced by ExprParser#LiteralExpression.
 	VisitLiteralExpression(ctx *LiteralExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#InExpression.
-	VisitInExpression(ctx *InExpressionContext) interface{}
-
 	// Visit a parse tree produced by ExprParser#ArrayLiteralExpression.
 	VisitArrayLiteralExpression(ctx *ArrayLiteralExpressionContext) interface{}
 
 	// Visit a parse tree produced by ExprParser#MemberDotExpression.
 	VisitMemberDotExpression(ctx *MemberDotExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#UnaryExpression.
-	VisitUnaryExpression(ctx *UnaryExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#RangeExpression.
-	VisitRangeExpression(ctx *RangeExpressionContext) interface{}
-
 	// Visit a parse tree produced by ExprParser#MemberIndexExpression.
 	VisitMemberIndexExpression(ctx *MemberIndexExpressionContext) interface{}
 
@@ -58,42 +82,18 @@ type ExprVisitor interface {
 	// Visit a parse tree produced by ExprParser#PointerExpression.
 	VisitPointerExpression(ctx *PointerExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#LogicalExpression.
-	VisitLogicalExpression(ctx *LogicalExpressionContext) interface{}
-
 	// Visit a parse tree produced by ExprParser#ClosureMemberDotExpression.
 	VisitClosureMemberDotExpression(ctx *ClosureMemberDotExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#EndsWithExpression.
-	VisitEndsWithExpression(ctx *EndsWithExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#StartsWithExpression.
-	VisitStartsWithExpression(ctx *StartsWithExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#EqualityExpression.
-	VisitEqualityExpression(ctx *EqualityExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#BuiltinLiteralExpression.
-	VisitBuiltinLiteralExpression(ctx *BuiltinLiteralExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#MultiplicativeExpression.
-	VisitMultiplicativeExpression(ctx *MultiplicativeExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#CallExpression.
-	VisitCallExpression(ctx *CallExpressionContext) interface{}
-
-	// Visit a parse tree produced by ExprParser#LenBuiltinExpression.
-	VisitLenBuiltinExpression(ctx *LenBuiltinExpressionContext) interface{}
+	// Visit a parse tree produced by ExprParser#arguments.
+	VisitArguments(ctx *ArgumentsContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#BuiltinExpression.
-	VisitBuiltinExpression(ctx *BuiltinExpressionContext) interface{}
+	// Visit a parse tree produced by ExprParser#argument.
+	VisitArgument(ctx *ArgumentContext) interface{}
 
 	// Visit a parse tree produced by ExprParser#ClosureExpression.
 	VisitClosureExpression(ctx *ClosureExpressionContext) interface{}
 
-	// Visit a parse tree produced by ExprParser#arguments.
-	VisitArguments(ctx *ArgumentsContext) interface{}
-
 	// Visit a parse tree produced by ExprParser#arrayLiteral.
 	VisitArrayLiteral(ctx *ArrayLiteralContext) interface{}
 
@@ -124,6 +124,9 @@ type ExprVisitor interface {
 	// Visit a parse tree produced by ExprParser#FloatExpression.
 	VisitFloatExpression(ctx *FloatExpressionContext) interface{}
 
+	// Visit a parse tree produced by ExprParser#DurationExpression.
+	VisitDurationExpression(ctx *DurationExpressionContext) interface{}
+
 	// Visit a parse tree produced by ExprParser#stringLiteral.
 	VisitStringLiteral(ctx *StringLiteralContext) interface{}
 
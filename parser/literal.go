@@ -0,0 +1,297 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/jakub-gawlas/expr/ast"
+	"github.com/jakub-gawlas/expr/file"
+)
+
+// parseInteger parses decimal, hex, octal and binary integer literals.
+// Literals out of int range, up to the largest uint64, are uint64 constants.
+func (p *parser) parseInteger(token antlr.Token) ast.Node {
+	s, base, digits := token.GetText(), "decimal", isDigit
+	if len(s) > 1 && s[0] == '0' {
+		switch s[1] {
+		case 'x', 'X':
+			base, digits = "hex", isHexDigit
+		case 'o', 'O':
+			base, digits = "octal", isOctalDigit
+		case 'b', 'B':
+			base, digits = "binary", isBinaryDigit
+		default:
+			p.errors.ReportError(locationToken(token), "parse error: invalid integer literal %v (use 0o prefix for octal)", s)
+			return &ast.IntegerNode{}
+		}
+	}
+	if !p.checkDigits(token, base, digits) {
+		return &ast.IntegerNode{}
+	}
+
+	u, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		p.errors.ReportError(locationToken(token), "parse error: integer literal %v overflows uint64", s)
+		return &ast.IntegerNode{}
+	}
+	if u > math.MaxInt64 {
+		return &ast.ConstantNode{Value: u}
+	}
+	return &ast.IntegerNode{Value: int(u)}
+}
+
+func (p *parser) parseFloat(token antlr.Token) ast.Node {
+	if !p.checkDigits(token, "decimal", isDigit) {
+		return &ast.FloatNode{}
+	}
+	s := token.GetText()
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			p.errors.ReportError(locationToken(token), "parse error: float literal %v overflows float64", s)
+		} else {
+			p.errors.ReportError(locationToken(token), "parse error: invalid float literal")
+		}
+	}
+	return &ast.FloatNode{Value: f}
+}
+
+// checkDigits reports the first invalid digit of the number literal, or
+// separator not placed between digits, at its location.
+func (p *parser) checkDigits(token antlr.Token, base string, digits func(rune) bool) bool {
+	s, start := token.GetText(), 0
+	if base != "decimal" {
+		start = 2
+		if len(s) == start {
+			p.errors.ReportError(locationToken(token), "parse error: %v literal has no digits", base)
+			return false
+		}
+	}
+
+	for i := start; i < len(s); i++ {
+		c := rune(s[i])
+		switch {
+		case c == '_':
+			if i > 0 && (digits(rune(s[i-1])) || i == start) && i+1 < len(s) && digits(rune(s[i+1])) {
+				continue
+			}
+			p.errors.ReportError(offset(token, i), "parse error: '_' must separate successive digits")
+			return false
+		case base == "decimal" && (c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'):
+			continue
+		case !digits(c):
+			p.errors.ReportError(offset(token, i), "parse error: invalid digit '%c' in %v literal", c, base)
+			return false
+		}
+	}
+	return true
+}
+
+// offset returns location of the byte at offset i of the single line token.
+func offset(token antlr.Token, i int) file.Location {
+	return file.NewLocation(token.GetLine(), token.GetColumn()+i)
+}
+
+// parseString returns value of the string literal, reporting an invalid
+// escape sequence at its location.
+func (p *parser) parseString(token antlr.Token) string {
+	text := token.GetText()
+	s, offset, err := unquote(text)
+	if err != nil {
+		line, column := token.GetLine(), token.GetColumn()
+		for _, r := range text[:offset] {
+			if r == '\n' {
+				line++
+				column = 0
+			} else {
+				column++
+			}
+		}
+		p.errors.ReportError(file.NewLocation(line, column), "syntax error: %v", err)
+	}
+	return s
+}
+
+// unquote returns value of the string literal. Escape sequences of quoted
+// strings are decoded, raw strings in backticks are returned as is. If an
+// escape sequence is invalid, unquote returns the error and byte offset of
+// the sequence in the literal.
+func unquote(s string) (string, int, error) {
+	if len(s) < 2 {
+		return s, 0, nil
+	}
+	quote := s[0]
+	if quote == '`' {
+		return s[1 : len(s)-1], 0, nil
+	}
+	if !strings.ContainsRune(s, '\\') {
+		return s[1 : len(s)-1], 0, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 1; i < len(s)-1; {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		r, n, err := unescape(s[i : len(s)-1])
+		if err != nil {
+			return "", i, err
+		}
+		if r >= 0 {
+			b.WriteRune(r)
+		}
+		i += n
+	}
+	return b.String(), 0, nil
+}
+
+// unescape decodes the escape sequence at the beginning of s and returns
+// the rune, which is -1 for line continuations, and length of the sequence.
+//
+// Supported escape sequences are:
+//
+//	\' \" \\ \b \f \n \r \t \v  single escape characters
+//	\0                      null character, not followed by a digit
+//	\xHH                    hex escape sequence
+//	\uHHHH                  unicode escape sequence, surrogate pairs are combined
+//	\u{H...}                extended unicode escape sequence
+//	\ followed by newline   line continuation
+//
+// Any other character following a backslash, except digits, stands for itself.
+func unescape(s string) (rune, int, error) {
+	if len(s) < 2 {
+		return 0, 0, fmt.Errorf("invalid escape sequence '%v'", s)
+	}
+	switch c := s[1]; c {
+	case '\'', '"', '\\':
+		return rune(c), 2, nil
+	case 'b':
+		return '\b', 2, nil
+	case 'f':
+		return '\f', 2, nil
+	case 'n':
+		return '\n', 2, nil
+	case 'r':
+		return '\r', 2, nil
+	case 't':
+		return '\t', 2, nil
+	case 'v':
+		return '\v', 2, nil
+	case '\n':
+		return -1, 2, nil
+	case '\r':
+		if strings.HasPrefix(s[2:], "\n") {
+			return -1, 3, nil
+		}
+		return -1, 2, nil
+
+	case '0':
+		if len(s) > 2 && isDigit(rune(s[2])) {
+			return 0, 0, fmt.Errorf("invalid escape sequence '%v'", s[:3])
+		}
+		return 0, 2, nil
+
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return 0, 0, fmt.Errorf("invalid escape sequence '%v'", s[:2])
+
+	case 'x':
+		r, ok := parseHex(s[2:], 2)
+		if !ok {
+			return 0, 0, fmt.Errorf("invalid hex escape sequence '%v'", prefix(s, 4))
+		}
+		return r, 4, nil
+
+	case 'u':
+		if strings.HasPrefix(s[2:], "{") {
+			end := strings.IndexByte(s, '}')
+			if end < 0 {
+				return 0, 0, fmt.Errorf("invalid unicode escape sequence '%v'", s)
+			}
+			r, ok := parseHex(s[3:end], end-3)
+			if !ok || end == 3 || r > unicode.MaxRune || utf16.IsSurrogate(r) {
+				return 0, 0, fmt.Errorf("invalid unicode escape sequence '%v'", s[:end+1])
+			}
+			return r, end + 1, nil
+		}
+		r, ok := parseHex(s[2:], 4)
+		if !ok {
+			return 0, 0, fmt.Errorf("invalid unicode escape sequence '%v'", prefix(s, 6))
+		}
+		if utf16.IsSurrogate(r) && len(s) >= 12 && s[6] == '\\' && s[7] == 'u' {
+			if r2, ok := parseHex(s[8:], 4); ok {
+				if d := utf16.DecodeRune(r, r2); d != unicode.ReplacementChar {
+					return d, 12, nil
+				}
+			}
+		}
+		return r, 6, nil
+	}
+
+	r, n := utf8.DecodeRuneInString(s[1:])
+	switch r {
+	case ' ', ' ':
+		return -1, 1 + n, nil
+	}
+	return r, 1 + n, nil
+}
+
+// parseHex parses exactly n hex digits at the beginning of s.
+func parseHex(s string, n int) (rune, bool) {
+	if len(s) < n {
+		return 0, false
+	}
+	var r rune
+	for _, c := range s[:n] {
+		if !isHexDigit(c) {
+			return 0, false
+		}
+		if r > unicode.MaxRune {
+			return r, true
+		}
+		r = r<<4 | hexValue(c)
+	}
+	return r, true
+}
+
+func hexValue(c rune) rune {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	}
+	return c - 'A' + 10
+}
+
+// prefix returns at most n bytes of s.
+func prefix(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isBinaryDigit(r rune) bool {
+	return r == '0' || r == '1'
+}
+
+func isOctalDigit(r rune) bool {
+	return '0' <= r && r <= '7'
+}
+
+func isHexDigit(r rune) bool {
+	return isDigit(r) || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F'
+}
@@ -6,20 +6,22 @@ import (
 	"fmt"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/jakub-gawlas/expr/ast"
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/file"
 	"github.com/jakub-gawlas/expr/parser/gen"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Tree struct {
 	Node   ast.Node
-	Source *helper.Source
+	Source *file.Source
 }
 
 func Parse(input string) (*Tree, error) {
-	source := helper.NewSource(input)
+	source := file.NewSource(input)
 	is := antlr.NewInputStream(input)
 
 	lexer := gen.NewExprLexer(is)
@@ -27,7 +29,7 @@ func Parse(input string) (*Tree, error) {
 	expr := gen.NewExprParser(stream)
 
 	p := &parser{
-		errors: helper.NewErrors(source),
+		errors: file.NewErrors(source),
 	}
 
 	lexer.RemoveErrorListeners()
@@ -35,10 +37,16 @@ func Parse(input string) (*Tree, error) {
 	lexer.AddErrorListener(p)
 	expr.AddErrorListener(p)
 
-	antlr.ParseTreeWalkerDefault.Walk(p, expr.Start())
+	start := expr.Start()
+	if p.errors.HasError() {
+		// Tree recovered from syntax errors can lack nodes.
+		return nil, p.errors.First()
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(p, start)
 
 	if p.errors.HasError() {
-		return nil, fmt.Errorf("%v", p.errors.First())
+		return nil, p.errors.First()
 	}
 	if len(p.stack) == 0 {
 		return nil, fmt.Errorf("empty stack")
@@ -54,9 +62,10 @@ func Parse(input string) (*Tree, error) {
 
 type parser struct {
 	*gen.BaseExprListener
-	stack   []ast.Node
-	errors  *helper.Errors
-	closure bool
+	stack  []ast.Node
+	errors *file.Errors
+	// closures is depth of closures the walker is in.
+	closures int
 }
 
 func (p *parser) push(node ast.Node) ast.Node {
@@ -87,43 +96,27 @@ func (p *parser) EnterIdentifierExpression(ctx *gen.IdentifierExpressionContext)
 }
 
 func (p *parser) EnterPointerExpression(ctx *gen.PointerExpressionContext) {
-	p.push(&ast.PointerNode{}).SetLocation(location(ctx))
+	p.push(&ast.PointerNode{Name: ctx.GetText()[1:]}).SetLocation(location(ctx))
 }
 
 func (p *parser) EnterStringLiteral(ctx *gen.StringLiteralContext) {
-	p.push(&ast.StringNode{Value: unquotes(ctx.GetText())}).SetLocation(location(ctx))
+	p.push(&ast.StringNode{Value: p.parseString(ctx.GetStart())}).SetLocation(location(ctx))
 }
 
 func (p *parser) EnterIntegerLiteral(ctx *gen.IntegerLiteralContext) {
-	if node := ctx.IntegerLiteral(); node != nil {
-		text := node.GetText()
-		text = strings.Replace(text, "_", "", -1)
-		i, err := strconv.ParseInt(text, 10, 64)
-		if err != nil {
-			p.reportError(ctx, "parse error: invalid int literal")
-			return
-		}
-		p.push(&ast.IntegerNode{Value: int(i)}).SetLocation(location(ctx))
-	} else if node := ctx.HexIntegerLiteral(); node != nil {
-		text := node.GetText()
-		i, err := strconv.ParseInt(text, 0, 64)
-		if err != nil {
-			p.reportError(ctx, "parse error: invalid hex literal")
-			return
-		}
-		p.push(&ast.IntegerNode{Value: int(i)}).SetLocation(location(ctx))
-	} else {
-		p.reportError(ctx, "parse error: invalid octal literal")
-	}
+	p.push(p.parseInteger(ctx.GetStart())).SetLocation(location(ctx))
 }
 
 func (p *parser) EnterFloatExpression(ctx *gen.FloatExpressionContext) {
-	f, err := strconv.ParseFloat(ctx.GetText(), 64)
+	p.push(p.parseFloat(ctx.GetStart())).SetLocation(location(ctx))
+}
+
+func (p *parser) EnterDurationExpression(ctx *gen.DurationExpressionContext) {
+	d, err := time.ParseDuration(ctx.GetText())
 	if err != nil {
-		p.reportError(ctx, "parse error: invalid float literal")
-		return
+		p.reportError(ctx, "parse error: invalid duration literal")
 	}
-	p.push(&ast.FloatNode{Value: f}).SetLocation(location(ctx))
+	p.push(&ast.DurationNode{Value: d}).SetLocation(location(ctx))
 }
 
 func (p *parser) EnterBooleanExpression(ctx *gen.BooleanExpressionContext) {
@@ -140,9 +133,15 @@ func (p *parser) EnterNilExpression(ctx *gen.NilExpressionContext) {
 }
 
 func (p *parser) ExitUnaryExpression(ctx *gen.UnaryExpressionContext) {
+	node := p.pop(ctx)
+	if c, ok := node.(*ast.ConstantNode); ok && ctx.GetOp().GetText() == "-" && c.Value == uint64(1<<63) {
+		// The smallest int is negated literal larger than the largest int.
+		p.push(&ast.IntegerNode{Value: math.MinInt64}).SetLocation(location(ctx))
+		return
+	}
 	p.push(&ast.UnaryNode{
 		Operator: ctx.GetOp().GetText(),
-		Node:     p.pop(ctx),
+		Node:     node,
 	}).SetLocation(location(ctx))
 }
 
@@ -247,11 +246,19 @@ func (p *parser) ExitLogicalExpression(ctx *gen.LogicalExpressionContext) {
 	}).SetLocation(locationToken(ctx.GetOp()))
 }
 
+func (p *parser) ExitNilCoalescingExpression(ctx *gen.NilCoalescingExpressionContext) {
+	p.push(&ast.BinaryNode{
+		Operator: "??",
+		Right:    p.pop(ctx),
+		Left:     p.pop(ctx),
+	}).SetLocation(locationToken(ctx.GetOp()))
+}
+
 func (p *parser) ExitCallExpression(ctx *gen.CallExpressionContext) {
 	expr := ctx.GetChild(0)
 	args := ctx.GetArgs()
 
-	var list []gen.IExprContext
+	var list []gen.IArgumentContext
 	if args != nil {
 		list = args.GetList()
 	}
@@ -264,17 +271,19 @@ func (p *parser) ExitCallExpression(ctx *gen.CallExpressionContext) {
 			Name:      p.pop(ctx).(*ast.IdentifierNode).Value,
 		}).SetLocation(location(ctx))
 	case *gen.MemberDotExpressionContext:
+		property := p.pop(ctx).(*ast.PropertyNode)
 		p.push(&ast.MethodNode{
 			Arguments: arguments,
 			Method:    c.GetName().GetText(),
-			Node:      p.pop(ctx).(*ast.PropertyNode).Node,
+			Node:      property.Node,
+			NilSafe:   property.NilSafe,
 		}).SetLocation(location(ctx))
 	default:
 		p.reportError(ctx, "parse error: undefined call expression")
 	}
 }
 
-func (p *parser) arguments(ctx antlr.ParserRuleContext, list []gen.IExprContext) []ast.Node {
+func (p *parser) arguments(ctx antlr.ParserRuleContext, list []gen.IArgumentContext) []ast.Node {
 	args := make([]ast.Node, 0)
 	for range list {
 		args = append([]ast.Node{p.pop(ctx)}, args...)
@@ -298,6 +307,7 @@ func (p *parser) ExitMemberDotExpression(ctx *gen.MemberDotExpressionContext) {
 	p.push(&ast.PropertyNode{
 		Node:     p.pop(ctx),
 		Property: property,
+		NilSafe:  ctx.GetOp().GetText() == "?.",
 	}).SetLocation(location(ctx))
 }
 
@@ -312,6 +322,19 @@ func (p *parser) ExitTernaryExpression(ctx *gen.TernaryExpressionContext) {
 	}).SetLocation(location(ctx))
 }
 
+func (p *parser) ExitLetExpression(ctx *gen.LetExpressionContext) {
+	body := p.pop(ctx)
+	value := p.pop(ctx)
+
+	// Location of the binding spans from let to the end of the value.
+	start, end := locationToken(ctx.GetStart()), location(ctx.GetValue())
+	p.push(&ast.LetNode{
+		Name:  ctx.GetName().GetText(),
+		Value: value,
+		Node:  body,
+	}).SetLocation(file.NewRange(start.Line(), start.Column(), end.EndLine(), end.EndColumn()))
+}
+
 func (p *parser) ExitArrayLiteralExpression(ctx *gen.ArrayLiteralExpressionContext) {
 	list := ctx.GetChild(0).(*gen.ArrayLiteralContext).GetList()
 	nodes := make([]ast.Node, 0)
@@ -343,7 +366,7 @@ func (p *parser) ExitPropertyAssignment(ctx *gen.PropertyAssignmentContext) {
 	if id := name.Identifier(); id != nil {
 		s = id.GetText()
 	} else if str := name.StringLiteral(); str != nil {
-		s = unquotes(str.GetText())
+		s = p.parseString(str.GetSymbol())
 	} else {
 		p.reportError(ctx, "parse error: invalid key type")
 	}
@@ -357,39 +380,25 @@ func (p *parser) ExitPropertyAssignment(ctx *gen.PropertyAssignmentContext) {
 	}).SetLocation(location(ctx))
 }
 
-func (p *parser) ExitLenBuiltinExpression(ctx *gen.LenBuiltinExpressionContext) {
-	p.push(&ast.BuiltinNode{
-		Name: "len",
-		Arguments: []ast.Node{
-			p.pop(ctx.GetE(), "parameter"),
-		},
-	}).SetLocation(location(ctx))
-}
-
-func (p *parser) ExitBuiltinExpression(ctx *gen.BuiltinExpressionContext) {
-	name := ctx.GetName().GetText()
-	closure := p.pop(ctx.GetC())
-	node := p.pop(ctx.GetE())
-
-	p.push(&ast.BuiltinNode{
-		Name:      name,
-		Arguments: []ast.Node{node, closure},
-	}).SetLocation(location(ctx))
-}
-
 func (p *parser) EnterClosureExpression(ctx *gen.ClosureExpressionContext) {
-	p.closure = true
+	p.closures++
 }
 
 func (p *parser) ExitClosureExpression(ctx *gen.ClosureExpressionContext) {
-	p.closure = false
+	p.closures--
+
+	var params []string
+	for _, param := range ctx.GetParams() {
+		params = append(params, param.GetText())
+	}
 	p.push(&ast.ClosureNode{
-		Node: p.pop(ctx),
+		Params: params,
+		Node:   p.pop(ctx),
 	}).SetLocation(location(ctx))
 }
 
 func (p *parser) ExitClosureMemberDotExpression(ctx *gen.ClosureMemberDotExpressionContext) {
-	if !p.closure {
+	if p.closures == 0 {
 		p.reportError(ctx, "parse error: dot property accessor can be only inside closure")
 		return
 	}
@@ -407,7 +416,7 @@ func (p *parser) ExitClosureMemberDotExpression(ctx *gen.ClosureMemberDotExpress
 }
 
 func (p *parser) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	p.errors.ReportError(helper.NewLocation(line, column), fmt.Sprintf("syntax error: %s", msg))
+	p.errors.ReportError(file.NewLocation(line, column), fmt.Sprintf("syntax error: %s", msg))
 }
 
 func (p *parser) ReportAmbiguity(_ antlr.Parser, _ *antlr.DFA, _, _ int, _ bool, _ *antlr.BitSet, _ antlr.ATNConfigSet) {
@@ -419,30 +428,40 @@ func (p *parser) ReportAttemptingFullContext(_ antlr.Parser, _ *antlr.DFA, _, _
 func (p *parser) ReportContextSensitivity(_ antlr.Parser, _ *antlr.DFA, _, _, _ int, _ antlr.ATNConfigSet) {
 }
 
-func unquotes(s string) string {
-	if len(s) >= 2 {
-		s = strings.Replace(s, string([]byte{'\\', s[0]}), string(s[0]), -1)
-		s = strings.Replace(s, `\\`, `\`, -1)
-		if c := s[len(s)-1]; s[0] == c && (c == '"' || c == '\'') {
-			return s[1 : len(s)-1]
-		}
+func location(ctx antlr.ParserRuleContext) file.Location {
+	if ctx == nil {
+		return file.NewLocation(0, 0)
 	}
-	return s
-}
 
-func location(ctx antlr.ParserRuleContext) helper.Location {
-	if ctx == nil {
-		return helper.NewLocation(0, 0)
+	start := ctx.GetStart()
+	if start == nil {
+		return file.NewLocation(0, 0)
 	}
 
-	token := ctx.GetStart()
-	if token == nil {
-		return helper.NewLocation(0, 0)
+	stop := ctx.GetStop()
+	if stop == nil || stop.GetTokenIndex() < start.GetTokenIndex() {
+		return locationToken(start)
 	}
 
-	return helper.NewLocation(token.GetLine(), token.GetColumn())
+	end := locationToken(stop)
+	return file.NewRange(start.GetLine(), start.GetColumn(), end.EndLine(), end.EndColumn())
 }
 
-func locationToken(token antlr.Token) helper.Location {
-	return helper.NewLocation(token.GetLine(), token.GetColumn())
+// locationToken returns location spanning the text of the token.
+func locationToken(token antlr.Token) file.Location {
+	line, column := token.GetLine(), token.GetColumn()
+	if token.GetTokenType() == antlr.TokenEOF {
+		return file.NewLocation(line, column)
+	}
+
+	endLine, endColumn := line, column
+	for _, r := range token.GetText() {
+		if r == '\n' {
+			endLine++
+			endColumn = 0
+		} else {
+			endColumn++
+		}
+	}
+	return file.NewRange(line, column, endLine, endColumn)
 }
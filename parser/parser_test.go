@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -27,6 +28,26 @@ func TestParse(t *testing.T) {
 			`'\'single\\ \''`,
 			&ast.StringNode{Value: "'single\\ '"},
 		},
+		{
+			`"\n\t\\ \x41\u00e9\u{1F600}\uD83D\uDE00 \0 \q"`,
+			&ast.StringNode{Value: "\n\t\\ A\u00e9\U0001F600\U0001F600 \x00 q"},
+		},
+		{
+			"'a\\\nb'",
+			&ast.StringNode{Value: "ab"},
+		},
+		{
+			"`^\\d+\\.\\d*$`",
+			&ast.StringNode{Value: `^\d+\.\d*$`},
+		},
+		{
+			"`multi\nline \\n`",
+			&ast.StringNode{Value: "multi\nline \\n"},
+		},
+		{
+			"{`a b`: 1}",
+			&ast.MapNode{Pairs: []*ast.PairNode{{Key: &ast.StringNode{Value: "a b"}, Value: &ast.IntegerNode{Value: 1}}}},
+		},
 		{
 			"3",
 			&ast.IntegerNode{Value: 3},
@@ -43,10 +64,86 @@ func TestParse(t *testing.T) {
 			"2.5",
 			&ast.FloatNode{Value: 2.5},
 		},
+		{
+			"1e9",
+			&ast.FloatNode{Value: 1e9},
+		},
+		{
+			"2.5e-3",
+			&ast.FloatNode{Value: 2.5e-3},
+		},
+		{
+			".5E+2",
+			&ast.FloatNode{Value: 50},
+		},
+		{
+			"1_000.000_5",
+			&ast.FloatNode{Value: 1000.0005},
+		},
+		{
+			"0b1010_1010",
+			&ast.IntegerNode{Value: 170},
+		},
+		{
+			"0o755",
+			&ast.IntegerNode{Value: 493},
+		},
+		{
+			"0x_FF_FF",
+			&ast.IntegerNode{Value: 65535},
+		},
+		{
+			"18446744073709551615",
+			&ast.ConstantNode{Value: uint64(18446744073709551615)},
+		},
+		{
+			"-9223372036854775808",
+			&ast.IntegerNode{Value: -9223372036854775808},
+		},
+		{
+			"-9223372036854775807",
+			&ast.UnaryNode{Operator: "-", Node: &ast.IntegerNode{Value: 9223372036854775807}},
+		},
+		{
+			"3h30m",
+			&ast.DurationNode{Value: 3*time.Hour + 30*time.Minute},
+		},
+		{
+			"1.5s + 100ms",
+			&ast.BinaryNode{Operator: "+", Left: &ast.DurationNode{Value: 1500 * time.Millisecond}, Right: &ast.DurationNode{Value: 100 * time.Millisecond}},
+		},
 		{
 			"true",
 			&ast.BoolNode{Value: true},
 		},
+		{
+			"Flags & 0x4 != 0",
+			&ast.BinaryNode{
+				Operator: "!=",
+				Left:     &ast.BinaryNode{Operator: "&", Left: &ast.IdentifierNode{Value: "Flags"}, Right: &ast.IntegerNode{Value: 4}},
+				Right:    &ast.IntegerNode{Value: 0},
+			},
+		},
+		{
+			"a | b ^ c &^ d << 1",
+			&ast.BinaryNode{
+				Operator: "^",
+				Left:     &ast.BinaryNode{Operator: "|", Left: &ast.IdentifierNode{Value: "a"}, Right: &ast.IdentifierNode{Value: "b"}},
+				Right: &ast.BinaryNode{
+					Operator: "<<",
+					Left:     &ast.BinaryNode{Operator: "&^", Left: &ast.IdentifierNode{Value: "c"}, Right: &ast.IdentifierNode{Value: "d"}},
+					Right:    &ast.IntegerNode{Value: 1},
+				},
+			},
+		},
+		{
+			"~a >> 2",
+			&ast.BinaryNode{
+				Operator: ">>",
+				Left:     &ast.UnaryNode{Operator: "~", Node: &ast.IdentifierNode{Value: "a"}},
+				Right:    &ast.IntegerNode{Value: 2},
+			},
+		},
 		{
 			"false",
 			&ast.BoolNode{},
@@ -98,6 +195,26 @@ func TestParse(t *testing.T) {
 			"foo.bar",
 			&ast.PropertyNode{Node: &ast.IdentifierNode{Value: "foo"}, Property: "bar"},
 		},
+		{
+			"foo?.bar.baz",
+			&ast.PropertyNode{Node: &ast.PropertyNode{Node: &ast.IdentifierNode{Value: "foo"}, Property: "bar", NilSafe: true}, Property: "baz"},
+		},
+		{
+			"foo?.bar()",
+			&ast.MethodNode{Node: &ast.IdentifierNode{Value: "foo"}, Method: "bar", Arguments: []ast.Node{}, NilSafe: true},
+		},
+		{
+			"a?.5:1",
+			&ast.ConditionalNode{Cond: &ast.IdentifierNode{Value: "a"}, Exp1: &ast.FloatNode{Value: .5}, Exp2: &ast.IntegerNode{Value: 1}},
+		},
+		{
+			"a ?? b ?? c || d",
+			&ast.BinaryNode{Operator: "??", Left: &ast.BinaryNode{Operator: "??", Left: &ast.IdentifierNode{Value: "a"}, Right: &ast.IdentifierNode{Value: "b"}}, Right: &ast.BinaryNode{Operator: "||", Left: &ast.IdentifierNode{Value: "c"}, Right: &ast.IdentifierNode{Value: "d"}}},
+		},
+		{
+			"a ? b : c ?? d",
+			&ast.ConditionalNode{Cond: &ast.IdentifierNode{Value: "a"}, Exp1: &ast.IdentifierNode{Value: "b"}, Exp2: &ast.BinaryNode{Operator: "??", Left: &ast.IdentifierNode{Value: "c"}, Right: &ast.IdentifierNode{Value: "d"}}},
+		},
 		{
 			"foo['all']",
 			&ast.IndexNode{Node: &ast.IdentifierNode{Value: "foo"}, Index: &ast.StringNode{Value: "all"}},
@@ -156,7 +273,7 @@ func TestParse(t *testing.T) {
 		},
 		{
 			"len(foo)",
-			&ast.BuiltinNode{Name: "len", Arguments: []ast.Node{&ast.IdentifierNode{Value: "foo"}}},
+			&ast.FunctionNode{Name: "len", Arguments: []ast.Node{&ast.IdentifierNode{Value: "foo"}}},
 		},
 		{
 			`foo matches "foo"`,
@@ -188,7 +305,47 @@ func TestParse(t *testing.T) {
 		},
 		{
 			"all(Tickets, {.Price > 0})",
-			&ast.BuiltinNode{Name: "all", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Tickets"}, &ast.ClosureNode{Node: &ast.BinaryNode{Operator: ">", Left: &ast.PropertyNode{Node: &ast.PointerNode{}, Property: "Price"}, Right: &ast.IntegerNode{Value: 0}}}}},
+			&ast.FunctionNode{Name: "all", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Tickets"}, &ast.ClosureNode{Node: &ast.BinaryNode{Operator: ">", Left: &ast.PropertyNode{Node: &ast.PointerNode{}, Property: "Price"}, Right: &ast.IntegerNode{Value: 0}}}}},
+		},
+		{
+			"let x = a + 1; x > 0 and x < 9",
+			&ast.LetNode{Name: "x", Value: &ast.BinaryNode{Operator: "+", Left: &ast.IdentifierNode{Value: "a"}, Right: &ast.IntegerNode{Value: 1}}, Node: &ast.BinaryNode{Operator: "and", Left: &ast.BinaryNode{Operator: ">", Left: &ast.IdentifierNode{Value: "x"}, Right: &ast.IntegerNode{}}, Right: &ast.BinaryNode{Operator: "<", Left: &ast.IdentifierNode{Value: "x"}, Right: &ast.IntegerNode{Value: 9}}}},
+		},
+		{
+			"let x = 1; let y = x; y",
+			&ast.LetNode{Name: "x", Value: &ast.IntegerNode{Value: 1}, Node: &ast.LetNode{Name: "y", Value: &ast.IdentifierNode{Value: "x"}, Node: &ast.IdentifierNode{Value: "y"}}},
+		},
+		{
+			"map(Orders, o => filter(o.Items, i => i.Sku == o.PrimarySku))",
+			&ast.FunctionNode{Name: "map", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Orders"}, &ast.ClosureNode{Params: []string{"o"}, Node: &ast.FunctionNode{Name: "filter", Arguments: []ast.Node{&ast.PropertyNode{Node: &ast.IdentifierNode{Value: "o"}, Property: "Items"}, &ast.ClosureNode{Params: []string{"i"}, Node: &ast.BinaryNode{Operator: "==", Left: &ast.PropertyNode{Node: &ast.IdentifierNode{Value: "i"}, Property: "Sku"}, Right: &ast.PropertyNode{Node: &ast.IdentifierNode{Value: "o"}, Property: "PrimarySku"}}}}}}}},
+		},
+		{
+			"all(Array, (x, i) => x > i)",
+			&ast.FunctionNode{Name: "all", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Array"}, &ast.ClosureNode{Params: []string{"x", "i"}, Node: &ast.BinaryNode{Operator: ">", Left: &ast.IdentifierNode{Value: "x"}, Right: &ast.IdentifierNode{Value: "i"}}}}},
+		},
+		{
+			"SortBy(Items, {.Price})",
+			&ast.FunctionNode{Name: "SortBy", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Items"}, &ast.ClosureNode{Node: &ast.PropertyNode{Node: &ast.PointerNode{}, Property: "Price"}}}},
+		},
+		{
+			"foo.Reduce((a, b) => a + b, {}, {a: 1}, (a))",
+			&ast.MethodNode{Node: &ast.IdentifierNode{Value: "foo"}, Method: "Reduce", Arguments: []ast.Node{&ast.ClosureNode{Params: []string{"a", "b"}, Node: &ast.BinaryNode{Operator: "+", Left: &ast.IdentifierNode{Value: "a"}, Right: &ast.IdentifierNode{Value: "b"}}}, &ast.MapNode{}, &ast.MapNode{Pairs: []*ast.PairNode{{Key: &ast.StringNode{Value: "a"}, Value: &ast.IntegerNode{Value: 1}}}}, &ast.IdentifierNode{Value: "a"}}},
+		},
+		{
+			"reduce(Items, {#acc + #}, 0)",
+			&ast.FunctionNode{Name: "reduce", Arguments: []ast.Node{&ast.IdentifierNode{Value: "Items"}, &ast.ClosureNode{Node: &ast.BinaryNode{Operator: "+", Left: &ast.PointerNode{Name: "acc"}, Right: &ast.PointerNode{}}}, &ast.IntegerNode{Value: 0}}},
+		},
+		{
+			"len(foo, 1)",
+			&ast.FunctionNode{Name: "len", Arguments: []ast.Node{&ast.IdentifierNode{Value: "foo"}, &ast.IntegerNode{Value: 1}}},
+		},
+		{
+			"map(foo, bar)",
+			&ast.FunctionNode{Name: "map", Arguments: []ast.Node{&ast.IdentifierNode{Value: "foo"}, &ast.IdentifierNode{Value: "bar"}}},
+		},
+		{
+			"1 + let x = 1; x",
+			&ast.BinaryNode{Operator: "+", Left: &ast.IntegerNode{Value: 1}, Right: &ast.LetNode{Name: "x", Value: &ast.IntegerNode{Value: 1}, Node: &ast.IdentifierNode{Value: "x"}}},
 		},
 	}
 	for _, test := range parseTests {
@@ -247,9 +404,93 @@ func TestParse_error(t *testing.T) {
 			"parse error: dot property accessor can be only inside closure",
 		},
 		{
-			`foo({.bar})`,
+			"foo?.",
+			"syntax error: missing Identifier at '<EOF>'",
+		},
+		{
+			`foo(1 + {.bar})`,
 			"syntax error: no viable alternative at input '{.'",
 		},
+		{
+			"map(Array, nil => 1)",
+			"syntax error: mismatched input '=>' expecting ')'",
+		},
+		{
+			"map(Array, (x, i, j) => 1)",
+			"syntax error: mismatched input ',' expecting ')'",
+		},
+		{
+			"3min",
+			"parse error: invalid duration literal (1:1)",
+		},
+		{
+			"let x = 1 x",
+			"syntax error: missing ';' at 'x'",
+		},
+		{
+			"let x = 1;",
+			"syntax error: mismatched input '<EOF>' expecting {",
+		},
+		{
+			"let true = 1; true",
+			"syntax error: mismatched input 'true' expecting Identifier",
+		},
+		{
+			`a + "abc\x4g"`,
+			"syntax error: invalid hex escape sequence '\\x4g' (1:9)\n | a + \"abc\\x4g\"\n | ........^",
+		},
+		{
+			`"é\u12"`,
+			"syntax error: invalid unicode escape sequence '\\u12' (1:3)",
+		},
+		{
+			"'a\\\nb\\u{110000}'",
+			"syntax error: invalid unicode escape sequence '\\u{110000}' (2:2)",
+		},
+		{
+			`"\1"`,
+			"syntax error: invalid escape sequence '\\1' (1:2)",
+		},
+		{
+			`"\01"`,
+			"syntax error: invalid escape sequence '\\01' (1:2)",
+		},
+		{
+			"18446744073709551616",
+			"parse error: integer literal 18446744073709551616 overflows uint64 (1:1)",
+		},
+		{
+			"1 + 1e400",
+			"parse error: float literal 1e400 overflows float64 (1:5)",
+		},
+		{
+			"0b1012",
+			"parse error: invalid digit '2' in binary literal (1:6)",
+		},
+		{
+			"0o78",
+			"parse error: invalid digit '8' in octal literal (1:4)",
+		},
+		{
+			"0x",
+			"parse error: hex literal has no digits (1:1)",
+		},
+		{
+			"1__000",
+			"parse error: '_' must separate successive digits (1:2)",
+		},
+		{
+			"1_000_.5",
+			"parse error: '_' must separate successive digits (1:6)",
+		},
+		{
+			"0755",
+			"parse error: invalid integer literal 0755 (use 0o prefix for octal) (1:1)",
+		},
+		{
+			"`abc",
+			"syntax error: extraneous input '`' expecting {",
+		},
 	}
 	for _, test := range parseErrorTests {
 		_, err := parser.Parse(test.input)
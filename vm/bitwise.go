@@ -0,0 +1,133 @@
+package vm
+
+import (
+	"reflect"
+)
+
+// Bitwise operators take only integers. Operands of the same type, or
+// kind, keep it, others are converted to uint64 if both are unsigned, and
+// to int64 otherwise, as in arithmetic. Shifts keep type of the left operand.
+
+// bitwise applies operator &, |, ^ or &^ to integers.
+func bitwise(a, b interface{}, op string) interface{} {
+	if x, ok := a.(int); ok {
+		if y, ok := b.(int); ok {
+			switch op {
+			case "&":
+				return x & y
+			case "|":
+				return x | y
+			case "^":
+				return x ^ y
+			case "&^":
+				return x &^ y
+			}
+		}
+	}
+
+	x, xu, ok1 := bits(a)
+	y, yu, ok2 := bits(b)
+	if !ok1 || !ok2 {
+		panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+	}
+
+	var z uint64
+	switch op {
+	case "&":
+		z = x & y
+	case "|":
+		z = x | y
+	case "^":
+		z = x ^ y
+	case "&^":
+		z = x &^ y
+	default:
+		panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+	}
+
+	switch ta, tb := reflect.TypeOf(a), reflect.TypeOf(b); {
+	case ta == tb:
+		return convertBits(z, a)
+	case ta.Kind() == tb.Kind():
+		// Literals typed as named integers are compiled to the basic
+		// type of their kind, so the result is of the named type.
+		if ta.PkgPath() == "" {
+			return convertBits(z, b)
+		}
+		return convertBits(z, a)
+	case xu && yu:
+		return z
+	}
+	return int64(z)
+}
+
+// shift shifts integer a left or right by b bits. Right shifts of signed
+// integers are arithmetic.
+func shift(a, b interface{}, op string) interface{} {
+	n, nu, ok := bits(b)
+	if !ok {
+		panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+	}
+	if !nu && int64(n) < 0 {
+		panic(newError(UnknownError, "negative shift amount (%v)", int64(n)))
+	}
+
+	if x, ok := a.(int); ok {
+		if op == "<<" {
+			return x << n
+		}
+		return x >> n
+	}
+
+	x, xu, ok := bits(a)
+	if !ok {
+		panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+	}
+	var z uint64
+	switch {
+	case op == "<<":
+		z = x << n
+	case xu:
+		z = x >> n
+	default:
+		z = uint64(int64(x) >> n)
+	}
+	return convertBits(z, a)
+}
+
+// complement returns integer with all bits of v inverted.
+func complement(v interface{}) interface{} {
+	if x, ok := v.(int); ok {
+		return ^x
+	}
+	x, _, ok := bits(v)
+	if !ok {
+		panic(newError(TypeMismatch, "invalid operation: ~ %T", v))
+	}
+	return convertBits(^x, v)
+}
+
+// bits returns bits of integer of any kind, extended to 64 bits,
+// and reports if the integer is unsigned.
+func bits(v interface{}) (uint64, bool, bool) {
+	n, _ := number(v)
+	switch x := n.(type) {
+	case int64:
+		return uint64(x), false, true
+	case uint64:
+		return x, true, true
+	}
+	return 0, false, false
+}
+
+// convertBits returns bits truncated to integer of the same type as v.
+func convertBits(z uint64, v interface{}) interface{} {
+	out := reflect.New(reflect.TypeOf(v)).Elem()
+	switch out.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		out.SetUint(z)
+	default:
+		out.SetInt(int64(z))
+	}
+	return out.Interface()
+}
@@ -1,5 +1,7 @@
 package vm
 
+// Opcodes are part of encoded programs: new opcodes are added to the end,
+// and changing existing ones requires bumping EncodingVersion.
 const (
 	OpPush byte = iota
 	OpPop
@@ -46,4 +48,47 @@ const (
 	OpEnd
 	OpStore
 	OpLoad
+	OpInSet
+	OpInRange
+	OpEqualInt
+	OpLessInt
+	OpMoreInt
+	OpLessOrEqualInt
+	OpMoreOrEqualInt
+	OpAddInt
+	OpSubtractInt
+	OpMultiplyInt
+	OpDivideInt
+	OpModuloInt
+	OpEqualFloat
+	OpLessFloat
+	OpMoreFloat
+	OpLessOrEqualFloat
+	OpMoreOrEqualFloat
+	OpAddFloat
+	OpSubtractFloat
+	OpMultiplyFloat
+	OpDivideFloat
+	OpAddString
+	OpJumpIfNil
+	OpJumpIfNotNil
+	OpPropertyOrNil
+	OpIndexOrNil
+	OpFetchOrNil
+	OpStoreSlot
+	OpLoadSlot
+	OpFunction
+	OpReturn
+	OpBuiltin
+	OpBitAnd
+	OpBitOr
+	OpBitXor
+	OpBitAndNot
+	OpShiftLeft
+	OpShiftRight
+	OpBitNot
+	OpNotEmpty
+
+	// opcodes is the number of opcodes, it must be the last.
+	opcodes
 )
@@ -0,0 +1,789 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jakub-gawlas/expr/file"
+)
+
+// EncodingVersion is a version of the program encoding. It must be bumped
+// on every incompatible change of the bytecode or the encoding format,
+// so programs encoded by other versions are rejected instead of misbehaving.
+const EncodingVersion = 13
+
+// Numbers of opcodes and constant kinds of EncodingVersion. Adding an
+// opcode or a kind breaks compilation, until the version is bumped and
+// these are updated along with it.
+const (
+	versionOpcodes = 85
+	versionKinds   = 23
+)
+
+var (
+	_ [opcodes - versionOpcodes]struct{}
+	_ [versionOpcodes - opcodes]struct{}
+	_ [kinds - versionKinds]struct{}
+	_ [versionKinds - kinds]struct{}
+)
+
+var magic = []byte("expr")
+
+// Kinds of encoded constants.
+const (
+	kindNil byte = iota
+	kindBool
+	kindString
+	kindInt
+	kindInt8
+	kindInt16
+	kindInt32
+	kindInt64
+	kindUint
+	kindUint8
+	kindUint16
+	kindUint32
+	kindUint64
+	kindFloat32
+	kindFloat64
+	kindRegexp
+	kindCall
+	kindArray
+	kindMap
+	kindSet
+	kindRange
+	kindBuiltin
+	kindDuration
+
+	// kinds is the number of constant kinds, it must be the last.
+	kinds
+)
+
+var kindNames = []string{
+	kindNil:      "nil",
+	kindBool:     "bool",
+	kindString:   "string",
+	kindInt:      "int",
+	kindInt8:     "int8",
+	kindInt16:    "int16",
+	kindInt32:    "int32",
+	kindInt64:    "int64",
+	kindUint:     "uint",
+	kindUint8:    "uint8",
+	kindUint16:   "uint16",
+	kindUint32:   "uint32",
+	kindUint64:   "uint64",
+	kindFloat32:  "float32",
+	kindFloat64:  "float64",
+	kindRegexp:   "regexp",
+	kindCall:     "call",
+	kindArray:    "array",
+	kindMap:      "map",
+	kindSet:      "set",
+	kindRange:    "range",
+	kindBuiltin:  "builtin",
+	kindDuration: "duration",
+}
+
+// MarshalBinary encodes program with its source, locations and constants.
+// Encoded data starts with a magic and EncodingVersion, and ends with
+// a checksum of the content.
+func (program *Program) MarshalBinary() (data []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	e := &encoder{}
+	e.buf.Write(magic)
+	e.uvarint(EncodingVersion)
+	e.program(program)
+
+	sum := crc32.ChecksumIEEE(e.buf.Bytes())
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], sum)
+	e.buf.Write(b[:])
+
+	return e.buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes program encoded by MarshalBinary.
+func (program *Program) UnmarshalBinary(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid program encoding: %v", r)
+		}
+	}()
+
+	if len(data) < len(magic)+4 || !bytes.Equal(data[:len(magic)], magic) {
+		return fmt.Errorf("invalid program encoding")
+	}
+
+	content, sum := data[:len(data)-4], binary.LittleEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(content) != sum {
+		return fmt.Errorf("invalid program encoding: checksum mismatch")
+	}
+
+	d := &decoder{data: content[len(magic):]}
+	if version := d.uvarint(); version != EncodingVersion {
+		return fmt.Errorf("unsupported program encoding version %v (expected %v)", version, EncodingVersion)
+	}
+	d.program(program)
+
+	if len(d.data) != 0 {
+		return fmt.Errorf("invalid program encoding: %v trailing bytes", len(d.data))
+	}
+	return nil
+}
+
+type jsonProgram struct {
+	Version   int             `json:"version"`
+	Checksum  uint32          `json:"checksum"`
+	Source    *string         `json:"source,omitempty"`
+	Locations [][4]int        `json:"locations"`
+	Constants []*jsonConstant `json:"constants"`
+	Bytecode  []byte          `json:"bytecode"`
+}
+
+type jsonConstant struct {
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// MarshalJSON encodes program as JSON object with version and checksum
+// of the program content.
+func (program *Program) MarshalJSON() (data []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	out := jsonProgram{
+		Version:   EncodingVersion,
+		Checksum:  checksum(program),
+		Locations: make([][4]int, len(program.Locations)),
+		Constants: make([]*jsonConstant, len(program.Constants)),
+		Bytecode:  program.Bytecode,
+	}
+	if program.Source != nil {
+		content := program.Source.Content()
+		out.Source = &content
+	}
+	for i, l := range program.Locations {
+		out.Locations[i] = [4]int{l.Line(), l.Column(), l.EndLine(), l.EndColumn()}
+	}
+	for i, c := range program.Constants {
+		out.Constants[i] = encodeJSONConstant(c)
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes program encoded by MarshalJSON.
+func (program *Program) UnmarshalJSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid program encoding: %v", r)
+		}
+	}()
+
+	var in jsonProgram
+	err = json.Unmarshal(data, &in)
+	if err != nil {
+		return err
+	}
+	if in.Version != EncodingVersion {
+		return fmt.Errorf("unsupported program encoding version %v (expected %v)", in.Version, EncodingVersion)
+	}
+
+	p := Program{
+		Locations: make([]file.Location, len(in.Locations)),
+		Constants: make([]interface{}, len(in.Constants)),
+		Bytecode:  in.Bytecode,
+	}
+	if in.Source != nil {
+		p.Source = file.NewSource(*in.Source)
+	}
+	for i, l := range in.Locations {
+		p.Locations[i] = file.NewRange(l[0], l[1], l[2], l[3])
+	}
+	for i, c := range in.Constants {
+		p.Constants[i] = decodeJSONConstant(c)
+	}
+
+	if checksum(&p) != in.Checksum {
+		return fmt.Errorf("invalid program encoding: checksum mismatch")
+	}
+
+	*program = p
+	return nil
+}
+
+// checksum of program content, independent of encoding.
+func checksum(program *Program) uint32 {
+	e := &encoder{}
+	e.program(program)
+	return crc32.ChecksumIEEE(e.buf.Bytes())
+}
+
+func kindOf(c interface{}) byte {
+	switch c.(type) {
+	case nil:
+		return kindNil
+	case bool:
+		return kindBool
+	case string:
+		return kindString
+	case int:
+		return kindInt
+	case int8:
+		return kindInt8
+	case int16:
+		return kindInt16
+	case int32:
+		return kindInt32
+	case int64:
+		return kindInt64
+	case uint:
+		return kindUint
+	case uint8:
+		return kindUint8
+	case uint16:
+		return kindUint16
+	case uint32:
+		return kindUint32
+	case uint64:
+		return kindUint64
+	case float32:
+		return kindFloat32
+	case float64:
+		return kindFloat64
+	case *regexp.Regexp:
+		return kindRegexp
+	case Call:
+		return kindCall
+	case []interface{}:
+		return kindArray
+	case map[string]interface{}:
+		return kindMap
+	case Set:
+		return kindSet
+	case Range:
+		return kindRange
+	case BuiltinCall:
+		return kindBuiltin
+	case time.Duration:
+		return kindDuration
+	}
+	panic(fmt.Sprintf("can't encode constant of type %T", c))
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) program(program *Program) {
+	if program.Source != nil {
+		e.buf.WriteByte(1)
+		e.string(program.Source.Content())
+	} else {
+		e.buf.WriteByte(0)
+	}
+
+	e.uvarint(uint64(len(program.Locations)))
+	for _, l := range program.Locations {
+		e.varint(int64(l.Line()))
+		e.varint(int64(l.Column()))
+		e.varint(int64(l.EndLine()))
+		e.varint(int64(l.EndColumn()))
+	}
+
+	e.uvarint(uint64(len(program.Constants)))
+	for _, c := range program.Constants {
+		e.constant(c)
+	}
+
+	e.uvarint(uint64(len(program.Bytecode)))
+	e.buf.Write(program.Bytecode)
+}
+
+func (e *encoder) constant(c interface{}) {
+	kind := kindOf(c)
+	e.buf.WriteByte(kind)
+
+	switch c := c.(type) {
+	case nil:
+	case bool:
+		if c {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	case string:
+		e.string(c)
+	case int:
+		e.varint(int64(c))
+	case int8:
+		e.varint(int64(c))
+	case int16:
+		e.varint(int64(c))
+	case int32:
+		e.varint(int64(c))
+	case int64:
+		e.varint(c)
+	case uint:
+		e.uvarint(uint64(c))
+	case uint8:
+		e.uvarint(uint64(c))
+	case uint16:
+		e.uvarint(uint64(c))
+	case uint32:
+		e.uvarint(uint64(c))
+	case uint64:
+		e.uvarint(c)
+	case float32:
+		e.uvarint(uint64(math.Float32bits(c)))
+	case float64:
+		e.uvarint(math.Float64bits(c))
+	case *regexp.Regexp:
+		e.string(c.String())
+	case Call:
+		e.string(c.Name)
+		e.varint(int64(c.Size))
+	case BuiltinCall:
+		e.string(c.Name)
+		e.varint(int64(c.Size))
+	case []interface{}:
+		e.uvarint(uint64(len(c)))
+		for _, item := range c {
+			e.constant(item)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(c))
+		for key := range c {
+			keys = append(keys, key)
+		}
+		// Sort keys to keep encoding and checksum deterministic.
+		sort.Strings(keys)
+		e.uvarint(uint64(len(keys)))
+		for _, key := range keys {
+			e.string(key)
+			e.constant(c[key])
+		}
+	case Set:
+		keys := c.keys()
+		e.uvarint(uint64(len(keys)))
+		for _, key := range keys {
+			e.constant(key)
+		}
+	case Range:
+		e.varint(int64(c.Min))
+		e.varint(int64(c.Max))
+	case time.Duration:
+		e.varint(int64(c))
+	}
+}
+
+func (e *encoder) uvarint(x uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], x)
+	e.buf.Write(b[:n])
+}
+
+func (e *encoder) varint(x int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], x)
+	e.buf.Write(b[:n])
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+type decoder struct {
+	data []byte
+}
+
+func (d *decoder) program(program *Program) {
+	var p Program
+
+	if d.byte() == 1 {
+		p.Source = file.NewSource(d.string())
+	}
+
+	p.Locations = make([]file.Location, d.size())
+	for i := range p.Locations {
+		line, column := int(d.varint()), int(d.varint())
+		endLine, endColumn := int(d.varint()), int(d.varint())
+		p.Locations[i] = file.NewRange(line, column, endLine, endColumn)
+	}
+
+	p.Constants = make([]interface{}, d.size())
+	for i := range p.Constants {
+		p.Constants[i] = d.constant()
+	}
+
+	p.Bytecode = d.bytes(d.size())
+
+	*program = p
+}
+
+func (d *decoder) constant() interface{} {
+	switch kind := d.byte(); kind {
+	case kindNil:
+		return nil
+	case kindBool:
+		return d.byte() == 1
+	case kindString:
+		return d.string()
+	case kindInt:
+		return int(d.varint())
+	case kindInt8:
+		return int8(d.varint())
+	case kindInt16:
+		return int16(d.varint())
+	case kindInt32:
+		return int32(d.varint())
+	case kindInt64:
+		return d.varint()
+	case kindUint:
+		return uint(d.uvarint())
+	case kindUint8:
+		return uint8(d.uvarint())
+	case kindUint16:
+		return uint16(d.uvarint())
+	case kindUint32:
+		return uint32(d.uvarint())
+	case kindUint64:
+		return d.uvarint()
+	case kindFloat32:
+		return math.Float32frombits(uint32(d.uvarint()))
+	case kindFloat64:
+		return math.Float64frombits(d.uvarint())
+	case kindRegexp:
+		return regexp.MustCompile(d.string())
+	case kindCall:
+		return Call{Name: d.string(), Size: int(d.varint())}
+	case kindArray:
+		array := make([]interface{}, d.size())
+		for i := range array {
+			array[i] = d.constant()
+		}
+		return array
+	case kindMap:
+		size := d.size()
+		m := make(map[string]interface{}, size)
+		for i := 0; i < size; i++ {
+			key := d.string()
+			m[key] = d.constant()
+		}
+		return m
+	case kindSet:
+		size := d.size()
+		set := make(Set, size)
+		for i := 0; i < size; i++ {
+			set[d.constant()] = struct{}{}
+		}
+		return set
+	case kindRange:
+		return Range{Min: int(d.varint()), Max: int(d.varint())}
+	case kindBuiltin:
+		return builtin(d.string(), int(d.varint()))
+	case kindDuration:
+		return time.Duration(d.varint())
+	default:
+		panic(fmt.Sprintf("unknown constant kind %v", kind))
+	}
+}
+
+func (d *decoder) byte() byte {
+	b := d.bytes(1)
+	return b[0]
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if n > len(d.data) {
+		panic("unexpected end of data")
+	}
+	b := make([]byte, n)
+	copy(b, d.data[:n])
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) uvarint() uint64 {
+	x, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		panic("malformed varint")
+	}
+	d.data = d.data[n:]
+	return x
+}
+
+func (d *decoder) varint() int64 {
+	x, n := binary.Varint(d.data)
+	if n <= 0 {
+		panic("malformed varint")
+	}
+	d.data = d.data[n:]
+	return x
+}
+
+// size reads length of a sequence, which can't be longer than remaining data.
+func (d *decoder) size() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		panic("unexpected end of data")
+	}
+	return int(n)
+}
+
+func (d *decoder) string() string {
+	return string(d.bytes(d.size()))
+}
+
+func encodeJSONConstant(c interface{}) *jsonConstant {
+	kind := kindOf(c)
+	var value interface{}
+
+	switch c := c.(type) {
+	case float32:
+		value = c
+		if s, ok := nonFinite(float64(c)); ok {
+			value = s
+		}
+	case float64:
+		value = c
+		if s, ok := nonFinite(c); ok {
+			value = s
+		}
+	case *regexp.Regexp:
+		value = c.String()
+	case []interface{}:
+		array := make([]*jsonConstant, len(c))
+		for i, item := range c {
+			array[i] = encodeJSONConstant(item)
+		}
+		value = array
+	case map[string]interface{}:
+		m := make(map[string]*jsonConstant, len(c))
+		for key, item := range c {
+			m[key] = encodeJSONConstant(item)
+		}
+		value = m
+	case Set:
+		keys := c.keys()
+		array := make([]*jsonConstant, len(keys))
+		for i, key := range keys {
+			array[i] = encodeJSONConstant(key)
+		}
+		value = array
+	default:
+		value = c
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return &jsonConstant{Kind: kindNames[kind], Value: raw}
+}
+
+func decodeJSONConstant(c *jsonConstant) interface{} {
+	if c == nil {
+		panic("null constant")
+	}
+
+	var kind = -1
+	for k, name := range kindNames {
+		if name == c.Kind {
+			kind = k
+		}
+	}
+
+	unmarshal := func(v interface{}) {
+		err := json.Unmarshal(c.Value, v)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	switch byte(kind) {
+	case kindNil:
+		return nil
+	case kindBool:
+		var v bool
+		unmarshal(&v)
+		return v
+	case kindString:
+		var v string
+		unmarshal(&v)
+		return v
+	case kindInt:
+		var v int
+		unmarshal(&v)
+		return v
+	case kindInt8:
+		var v int8
+		unmarshal(&v)
+		return v
+	case kindInt16:
+		var v int16
+		unmarshal(&v)
+		return v
+	case kindInt32:
+		var v int32
+		unmarshal(&v)
+		return v
+	case kindInt64:
+		var v int64
+		unmarshal(&v)
+		return v
+	case kindUint:
+		var v uint
+		unmarshal(&v)
+		return v
+	case kindUint8:
+		var v uint8
+		unmarshal(&v)
+		return v
+	case kindUint16:
+		var v uint16
+		unmarshal(&v)
+		return v
+	case kindUint32:
+		var v uint32
+		unmarshal(&v)
+		return v
+	case kindUint64:
+		var v uint64
+		unmarshal(&v)
+		return v
+	case kindFloat32:
+		return float32(decodeJSONFloat(c.Value, 32))
+	case kindFloat64:
+		return decodeJSONFloat(c.Value, 64)
+	case kindRegexp:
+		var v string
+		unmarshal(&v)
+		return regexp.MustCompile(v)
+	case kindCall:
+		var v Call
+		unmarshal(&v)
+		return v
+	case kindArray:
+		var v []*jsonConstant
+		unmarshal(&v)
+		array := make([]interface{}, len(v))
+		for i, item := range v {
+			array[i] = decodeJSONConstant(item)
+		}
+		return array
+	case kindMap:
+		var v map[string]*jsonConstant
+		unmarshal(&v)
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[key] = decodeJSONConstant(item)
+		}
+		return m
+	case kindSet:
+		var v []*jsonConstant
+		unmarshal(&v)
+		set := make(Set, len(v))
+		for _, item := range v {
+			set[decodeJSONConstant(item)] = struct{}{}
+		}
+		return set
+	case kindRange:
+		var v Range
+		unmarshal(&v)
+		return v
+	case kindBuiltin:
+		var v BuiltinCall
+		unmarshal(&v)
+		return builtin(v.Name, v.Size)
+	case kindDuration:
+		var v int64
+		unmarshal(&v)
+		return time.Duration(v)
+	default:
+		panic(fmt.Sprintf("unknown constant kind %q", c.Kind))
+	}
+}
+
+// builtin resolves func of the decoded builtin call, so programs using
+// builtins implemented in Go are decoded only where they are registered.
+func builtin(name string, size int) BuiltinCall {
+	fn, ok := LookupFunc(name)
+	if !ok {
+		panic(fmt.Sprintf("unknown builtin %v", name))
+	}
+	return BuiltinCall{Name: name, Size: size, Func: fn, StringSize: sizes[name]}
+}
+
+// nonFinite returns NaN and infinities as strings, as JSON has no numbers
+// for them.
+func nonFinite(f float64) (string, bool) {
+	switch {
+	case math.IsNaN(f):
+		return "NaN", true
+	case math.IsInf(f, 1):
+		return "+Inf", true
+	case math.IsInf(f, -1):
+		return "-Inf", true
+	}
+	return "", false
+}
+
+// decodeJSONFloat decodes float encoded as JSON number, or as string
+// returned by nonFinite.
+func decodeJSONFloat(raw json.RawMessage, bitSize int) float64 {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		switch s {
+		case "NaN", "+Inf", "-Inf":
+			f, _ := strconv.ParseFloat(s, bitSize)
+			return f
+		}
+		panic(fmt.Sprintf("invalid float %q", s))
+	}
+	if bitSize == 32 {
+		var f float32
+		if err := json.Unmarshal(raw, &f); err != nil {
+			panic(err)
+		}
+		return float64(f)
+	}
+	var f float64
+	if err := json.Unmarshal(raw, &f); err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// keys of the set in deterministic order.
+func (s Set) keys() []interface{} {
+	keys := make([]interface{}, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if ka, kb := kindOf(a), kindOf(b); ka != kb {
+			return ka < kb
+		}
+		return fmt.Sprintf("%v", a) < fmt.Sprintf("%v", b)
+	})
+	return keys
+}
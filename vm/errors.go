@@ -0,0 +1,163 @@
+package vm
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/jakub-gawlas/expr/file"
+)
+
+// ErrorKind classifies runtime errors.
+type ErrorKind int
+
+const (
+	UnknownError ErrorKind = iota
+	// TypeMismatch is an operation on values of unsupported types.
+	TypeMismatch
+	// FieldNotFound is an access to missing struct field or map key.
+	FieldNotFound
+	// FunctionNotFound is a call of missing function or method.
+	FunctionNotFound
+	// IndexOutOfRange is an access to array or string by invalid index.
+	IndexOutOfRange
+	// DivisionByZero is an integer division or modulo by zero.
+	DivisionByZero
+	// InvalidRegexp is a matches operator with invalid pattern.
+	InvalidRegexp
+	// NilDereference is an access to field or method of nil value.
+	NilDereference
+	// IntegerOverflow is a conversion of float out of int range, or NaN, to int.
+	IntegerOverflow
+	// EmptyArray is a builtin, like min or first, called with array without items.
+	EmptyArray
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case TypeMismatch:
+		return "type mismatch"
+	case FieldNotFound:
+		return "field not found"
+	case FunctionNotFound:
+		return "function not found"
+	case IndexOutOfRange:
+		return "index out of range"
+	case DivisionByZero:
+		return "division by zero"
+	case InvalidRegexp:
+		return "invalid regexp"
+	case NilDereference:
+		return "nil dereference"
+	case IntegerOverflow:
+		return "integer overflow"
+	case EmptyArray:
+		return "empty array"
+	default:
+		return "unknown error"
+	}
+}
+
+// RuntimeError is returned by Run if program evaluation failed.
+type RuntimeError struct {
+	Kind    ErrorKind
+	Message string
+	// Location of the instruction which failed.
+	Location file.Location
+	// Snippet is a line of source with the error location marked.
+	Snippet string
+}
+
+func (e *RuntimeError) Error() string {
+	h := file.Error{
+		Location: e.Location,
+		Message:  e.Message,
+		Snippet:  e.Snippet,
+	}
+	return h.Error()
+}
+
+func newError(kind ErrorKind, format string, args ...interface{}) *RuntimeError {
+	return &RuntimeError{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// errorKind classifies panics raised by Go runtime during evaluation.
+func errorKind(r interface{}) ErrorKind {
+	switch e := r.(type) {
+	case *runtime.TypeAssertionError:
+		return TypeMismatch
+	case runtime.Error:
+		msg := e.Error()
+		if strings.Contains(msg, "divide by zero") {
+			return DivisionByZero
+		}
+		if strings.Contains(msg, "index out of range") {
+			return IndexOutOfRange
+		}
+	case string:
+		if strings.Contains(e, "index out of range") {
+			return IndexOutOfRange
+		}
+	}
+	return UnknownError
+}
+
+// ContextError is returned by Run if program evaluation was interrupted
+// because the context was canceled or its deadline exceeded.
+type ContextError struct {
+	// Err is the error returned by context, context.Canceled
+	// or context.DeadlineExceeded.
+	Err error
+	// Location of the instruction being executed at the moment of interruption.
+	Location file.Location
+	// Snippet is a line of source with the location marked.
+	Snippet string
+}
+
+func (e *ContextError) Error() string {
+	h := file.Error{
+		Location: e.Location,
+		Message:  e.Err.Error(),
+		Snippet:  e.Snippet,
+	}
+	return h.Error()
+}
+
+// Unwrap returns the context error, so errors.Is(err, context.Canceled) works.
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
+// Limit names one of the execution budgets of VM.
+type Limit string
+
+const (
+	InstructionsLimit Limit = "instructions"
+	StackSizeLimit    Limit = "stack size"
+	RangeSizeLimit    Limit = "range size"
+	AllocationsLimit  Limit = "allocations"
+	StringSizeLimit   Limit = "string size"
+)
+
+// BudgetExceeded is returned by Run if program evaluation exceeded
+// one of the limits set by options.
+type BudgetExceeded struct {
+	Limit Limit
+	Max   int
+	// Location of the instruction which exceeded the limit.
+	Location file.Location
+	// Snippet is a line of source with the location marked.
+	Snippet string
+}
+
+func (e *BudgetExceeded) Error() string {
+	h := file.Error{
+		Location: e.Location,
+		Message:  fmt.Sprintf("budget exceeded: %v limit of %v", e.Limit, e.Max),
+		Snippet:  e.Snippet,
+	}
+	return h.Error()
+}
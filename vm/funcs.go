@@ -0,0 +1,70 @@
+package vm
+
+import (
+	"reflect"
+)
+
+// Func is a Go implementation of a builtin, called with OpBuiltin.
+type Func func(args ...interface{}) (interface{}, error)
+
+// closureType is type of closures passed to Func.
+var closureType = reflect.TypeOf(func(...interface{}) interface{} { return nil })
+
+// StringSize returns size in bytes of the string, which Func would build
+// from the arguments, so VM can report exceeding MaxStringSize before the
+// string is built. It returns 0 for invalid arguments, reported by Func.
+type StringSize func(args ...interface{}) int
+
+var (
+	funcs = make(map[string]Func)
+	sizes = make(map[string]StringSize)
+)
+
+// RegisterFunc makes the func available to decoded programs by the name.
+// Builtins should be registered with builtin.Register instead. It must be
+// called from init, as the registry is not guarded by locks.
+func RegisterFunc(name string, fn Func) {
+	funcs[name] = fn
+}
+
+// LookupFunc returns the builtin func registered with the name.
+func LookupFunc(name string) (Func, bool) {
+	fn, ok := funcs[name]
+	return fn, ok
+}
+
+// RegisterStringSize sets size of strings built by the func registered with
+// the name. Like RegisterFunc, it must be called from init.
+func RegisterStringSize(name string, size StringSize) {
+	sizes[name] = size
+}
+
+// Less reports whether a is less than b with semantics of the < operator.
+// It panics if the values can't be compared.
+func Less(a, b interface{}) bool {
+	return less(a, b).(bool)
+}
+
+// Equal reports whether a equals b with semantics of the == operator.
+func Equal(a, b interface{}) bool {
+	return equal(a, b)
+}
+
+// ToInt converts number of any numeric type to int, truncating floats.
+// It panics if the value is not a number, or is a float out of int range
+// or NaN.
+func ToInt(v interface{}) int {
+	return toInt(v)
+}
+
+// IsIntRange reports whether the float can be truncated to int without
+// overflow. It is false for NaN.
+func IsIntRange(f float64) bool {
+	return f >= float64(minInt) && f < -float64(minInt)
+}
+
+// ToFloat64 converts number of any numeric type to float64.
+// It panics if the value is not a number.
+func ToFloat64(v interface{}) float64 {
+	return toFloat64(v)
+}
@@ -0,0 +1,41 @@
+package vm
+
+// RunOption configures limits of VM.
+type RunOption func(vm *VM)
+
+// MaxInstructions limits number of instructions program may execute.
+func MaxInstructions(n int) RunOption {
+	return func(vm *VM) {
+		vm.maxSteps = n
+	}
+}
+
+// MaxStackSize limits number of values on the VM stack.
+func MaxStackSize(n int) RunOption {
+	return func(vm *VM) {
+		vm.maxStack = n
+	}
+}
+
+// MaxRangeSize limits length of a range created by `..` operator at runtime.
+func MaxRangeSize(n int) RunOption {
+	return func(vm *VM) {
+		vm.maxRange = n
+	}
+}
+
+// MaxStringSize limits size in bytes of strings built by builtins, like repeat.
+func MaxStringSize(n int) RunOption {
+	return func(vm *VM) {
+		vm.maxString = n
+	}
+}
+
+// MaxAllocations limits total number of elements allocated by
+// arrays, maps and ranges created during program evaluation,
+// including constant arrays and results of builtins.
+func MaxAllocations(n int) RunOption {
+	return func(vm *VM) {
+		vm.maxAllocations = n
+	}
+}
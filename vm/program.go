@@ -3,13 +3,13 @@ package vm
 import (
 	"encoding/binary"
 	"fmt"
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/file"
 	"regexp"
 )
 
 type Program struct {
-	Source    *helper.Source
-	Locations []helper.Location
+	Source    *file.Source
+	Locations []file.Location
 	Constants []interface{}
 	Bytecode  []byte
 }
@@ -185,6 +185,126 @@ func (program *Program) Disassemble() string {
 		case OpLoad:
 			constant("OpLoad")
 
+		case OpInSet:
+			constant("OpInSet")
+
+		case OpInRange:
+			constant("OpInRange")
+
+		case OpEqualInt:
+			op("OpEqualInt")
+
+		case OpLessInt:
+			op("OpLessInt")
+
+		case OpMoreInt:
+			op("OpMoreInt")
+
+		case OpLessOrEqualInt:
+			op("OpLessOrEqualInt")
+
+		case OpMoreOrEqualInt:
+			op("OpMoreOrEqualInt")
+
+		case OpAddInt:
+			op("OpAddInt")
+
+		case OpSubtractInt:
+			op("OpSubtractInt")
+
+		case OpMultiplyInt:
+			op("OpMultiplyInt")
+
+		case OpDivideInt:
+			op("OpDivideInt")
+
+		case OpModuloInt:
+			op("OpModuloInt")
+
+		case OpEqualFloat:
+			op("OpEqualFloat")
+
+		case OpLessFloat:
+			op("OpLessFloat")
+
+		case OpMoreFloat:
+			op("OpMoreFloat")
+
+		case OpLessOrEqualFloat:
+			op("OpLessOrEqualFloat")
+
+		case OpMoreOrEqualFloat:
+			op("OpMoreOrEqualFloat")
+
+		case OpAddFloat:
+			op("OpAddFloat")
+
+		case OpSubtractFloat:
+			op("OpSubtractFloat")
+
+		case OpMultiplyFloat:
+			op("OpMultiplyFloat")
+
+		case OpDivideFloat:
+			op("OpDivideFloat")
+
+		case OpAddString:
+			op("OpAddString")
+
+		case OpJumpIfNil:
+			jump("OpJumpIfNil")
+
+		case OpJumpIfNotNil:
+			jump("OpJumpIfNotNil")
+
+		case OpPropertyOrNil:
+			constant("OpPropertyOrNil")
+
+		case OpFetchOrNil:
+			constant("OpFetchOrNil")
+
+		case OpIndexOrNil:
+			op("OpIndexOrNil")
+
+		case OpStoreSlot:
+			arg("OpStoreSlot")
+
+		case OpLoadSlot:
+			arg("OpLoadSlot")
+
+		case OpFunction:
+			jump("OpFunction")
+
+		case OpReturn:
+			op("OpReturn")
+
+		case OpBuiltin:
+			constant("OpBuiltin")
+
+		case OpBitAnd:
+			op("OpBitAnd")
+
+		case OpBitOr:
+			op("OpBitOr")
+
+		case OpBitXor:
+			op("OpBitXor")
+
+		case OpBitAndNot:
+			op("OpBitAndNot")
+
+		case OpShiftLeft:
+			op("OpShiftLeft")
+
+		case OpShiftRight:
+			op("OpShiftRight")
+
+		case OpBitNot:
+			op("OpBitNot")
+
+		case OpNotEmpty:
+			constant("OpNotEmpty")
+
 		default:
 			out += fmt.Sprintf("%v\t%#x\n", cp, b)
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"time"
 )
 
 type Call struct {
@@ -11,14 +12,106 @@ type Call struct {
 	Size int
 }
 
+// BuiltinCall is a call of builtin implemented in Go. Func and StringSize are
+// resolved by name at compile time, and when the program is decoded.
+type BuiltinCall struct {
+	Name       string
+	Size       int
+	Func       Func       `json:"-"`
+	StringSize StringSize `json:"-"`
+}
+
+// GoString omits funcs, which can't be printed.
+func (b BuiltinCall) GoString() string {
+	return fmt.Sprintf("vm.BuiltinCall{Name:%q, Size:%v}", b.Name, b.Size)
+}
+
 type Scope map[string]interface{}
 
+// Function is a closure passed to Go func as an argument. Its body
+// starts at IP and takes Arity parameters.
+type Function struct {
+	IP    int
+	Arity int
+}
+
+// Set is a constant collection for `in` operator, which checks
+// membership in constant time instead of scanning an array.
+type Set map[interface{}]struct{}
+
+// NewSet creates set of values. It returns false if some of values
+// can't be used as a set key.
+func NewSet(values []interface{}) (Set, bool) {
+	set := make(Set, len(values))
+	for _, v := range values {
+		key := setKey(v)
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			return nil, false
+		}
+		set[key] = struct{}{}
+	}
+	return set, true
+}
+
+// Contains reports whether value is in the set. Numbers of different
+// types are equal if they have the same value.
+func (s Set) Contains(value interface{}) bool {
+	key := setKey(value)
+	if key != nil && !reflect.TypeOf(key).Comparable() {
+		return false
+	}
+	_, ok := s[key]
+	return ok
+}
+
+// setKey normalizes numbers, including named numeric types, to int64,
+// uint64 or float64.
+func setKey(v interface{}) interface{} {
+	n, ok := number(v)
+	if !ok {
+		return v
+	}
+	switch x := n.(type) {
+	case uint64:
+		if x <= math.MaxInt64 {
+			return int64(x)
+		}
+	case float64:
+		if x == math.Trunc(x) && x >= math.MinInt64 && x < math.MaxInt64 {
+			return int64(x)
+		}
+	}
+	return n
+}
+
+// Range is a constant range of integers for `in` operator,
+// which is checked by bounds instead of creating the range.
+type Range struct {
+	Min int
+	Max int
+}
+
+// Contains reports whether value is an integer within the range.
+func (r Range) Contains(value interface{}) bool {
+	if i, ok := setKey(value).(int64); ok {
+		return int64(r.Min) <= i && i <= int64(r.Max)
+	}
+	return false
+}
+
 func fetch(from interface{}, i interface{}) interface{} {
+	if from == nil {
+		panic(newError(NilDereference, "cannot fetch %v from nil", i))
+	}
+
 	v := reflect.ValueOf(from)
 	switch v.Kind() {
 
 	case reflect.Array, reflect.Slice, reflect.String:
 		index := toInt(i)
+		if index < 0 || index >= v.Len() {
+			panic(newError(IndexOutOfRange, "index out of range [%v] with length %v", index, v.Len()))
+		}
 		value := v.Index(int(index))
 		if value.IsValid() && value.CanInterface() {
 			return value.Interface()
@@ -37,13 +130,87 @@ func fetch(from interface{}, i interface{}) interface{} {
 		}
 
 	case reflect.Ptr:
+		if v.IsNil() {
+			panic(newError(NilDereference, "cannot fetch %v from nil %T", i, from))
+		}
 		value := v.Elem()
 		if value.IsValid() && value.CanInterface() {
 			return fetch(value.Interface(), i)
 		}
 
 	}
-	panic(fmt.Sprintf("%v doesn't contains %v", from, i))
+	panic(newError(FieldNotFound, "%v doesn't contains %v", from, i))
+}
+
+// shared reports if there are array or map constants, which are shared
+// by all runs of the program.
+func shared(constants []interface{}) bool {
+	for _, c := range constants {
+		switch c.(type) {
+		case []interface{}, map[string]interface{}:
+			return true
+		}
+	}
+	return false
+}
+
+// clone returns deep copy of arrays and maps of the result, so the caller
+// can modify it without changing constants of the program.
+func clone(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		array := make([]interface{}, len(v))
+		for i, item := range v {
+			array[i] = clone(item)
+		}
+		return array
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[key] = clone(item)
+		}
+		return m
+	}
+	return value
+}
+
+// allocations returns number of items of the array or the map, which are
+// charged to the allocations budget when constants are pushed and when
+// builtins return.
+func allocations(value interface{}) int {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v)
+	case map[string]interface{}:
+		return len(v)
+	case nil, bool, int, float64, string:
+		return 0
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len()
+	}
+	return 0
+}
+
+// fetchOrNil is like fetch, but results in nil for missing map keys.
+func fetchOrNil(from interface{}, i interface{}) interface{} {
+	v := reflect.ValueOf(from)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Map {
+		key := reflect.ValueOf(i)
+		if !key.IsValid() || !key.Type().AssignableTo(v.Type().Key()) {
+			return nil
+		}
+		value := v.MapIndex(key)
+		if !value.IsValid() {
+			return nil
+		}
+	}
+	return fetch(from, i)
 }
 
 func fetchFn(from interface{}, name string) reflect.Value {
@@ -76,7 +243,72 @@ func fetchFn(from interface{}, name string) reflect.Value {
 			return value
 		}
 	}
-	panic(fmt.Sprintf(`can't get "%v" from %T`, name, from))
+	if isNil(from) {
+		panic(newError(NilDereference, "cannot call %v on nil %T", name, from))
+	}
+	panic(newError(FunctionNotFound, `can't get "%v" from %T`, name, from))
+}
+
+// argument converts value to an argument of a call, nil is passed
+// as the zero value of the parameter type.
+func argument(value interface{}, t reflect.Type) reflect.Value {
+	if value == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(value)
+}
+
+// result converts value returned by function to the result type of func.
+func result(value interface{}, t reflect.Type) reflect.Value {
+	v := argument(value, t)
+	switch {
+	case t.Kind() == reflect.Interface || v.Type() == t:
+		return v
+	case v.Type().AssignableTo(t):
+		return v.Convert(t)
+	case isNumber(value) && isNumberKind(t.Kind()):
+		// Floats are not converted to integers, which would truncate them.
+		if !isFloatKind(v.Kind()) || isFloatKind(t.Kind()) {
+			return v.Convert(t)
+		}
+	}
+	panic(newError(TypeMismatch, "cannot use %T as %v in return", value, t))
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// paramType returns type of i-th parameter of the func.
+func paramType(fn reflect.Type, i int) reflect.Type {
+	if fn.IsVariadic() && i >= fn.NumIn()-1 {
+		return fn.In(fn.NumIn() - 1).Elem()
+	}
+	return fn.In(i)
+}
+
+// isNil reports if value is nil, including typed nil,
+// like a nil pointer stored in interface.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
 }
 
 func in(needle interface{}, array interface{}) bool {
@@ -101,7 +333,7 @@ func in(needle interface{}, array interface{}) bool {
 	case reflect.Map:
 		n := reflect.ValueOf(needle)
 		if !n.IsValid() {
-			panic(fmt.Sprintf("cannot use %T as index to %T", needle, array))
+			panic(newError(TypeMismatch, "cannot use %T as index to %T", needle, array))
 		}
 		value := v.MapIndex(n)
 		if value.IsValid() {
@@ -112,7 +344,7 @@ func in(needle interface{}, array interface{}) bool {
 	case reflect.Struct:
 		n := reflect.ValueOf(needle)
 		if !n.IsValid() || n.Kind() != reflect.String {
-			panic(fmt.Sprintf("cannot use %T as field name of %T", needle, array))
+			panic(newError(TypeMismatch, "cannot use %T as field name of %T", needle, array))
 		}
 		value := v.FieldByName(n.String())
 		if value.IsValid() {
@@ -128,16 +360,16 @@ func in(needle interface{}, array interface{}) bool {
 		return false
 	}
 
-	panic(fmt.Sprintf(`operator "in"" not defined on %T`, array))
+	panic(newError(TypeMismatch, `operator "in"" not defined on %T`, array))
 }
 
 func length(a interface{}) int {
 	v := reflect.ValueOf(a)
 	switch v.Kind() {
-	case reflect.Array, reflect.Slice, reflect.String:
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.String:
 		return v.Len()
 	default:
-		panic(fmt.Sprintf("invalid argument for len (type %T)", a))
+		panic(newError(TypeMismatch, "invalid argument for len (type %T)", a))
 	}
 }
 
@@ -170,231 +402,429 @@ func negate(i interface{}) interface{} {
 	case uint64:
 		return -v
 
+	case time.Duration:
+		return -v
+
 	default:
-		panic(fmt.Sprintf("invalid operation: - %T", v))
+		panic(newError(TypeMismatch, "invalid operation: - %T", v))
 	}
 }
 
 func equal(a, b interface{}) bool {
 	switch x := a.(type) {
 	case float32:
-		return x == b.(float32)
+		if y, ok := b.(float32); ok {
+			return x == y
+		}
 	case float64:
-		return x == b.(float64)
+		if y, ok := b.(float64); ok {
+			return x == y
+		}
 
 	case int:
-		return x == b.(int)
+		if y, ok := b.(int); ok {
+			return x == y
+		}
 	case int8:
-		return x == b.(int8)
+		if y, ok := b.(int8); ok {
+			return x == y
+		}
 	case int16:
-		return x == b.(int16)
+		if y, ok := b.(int16); ok {
+			return x == y
+		}
 	case int32:
-		return x == b.(int32)
+		if y, ok := b.(int32); ok {
+			return x == y
+		}
 	case int64:
-		return x == b.(int64)
+		if y, ok := b.(int64); ok {
+			return x == y
+		}
 
 	case uint:
-		return x == b.(uint)
+		if y, ok := b.(uint); ok {
+			return x == y
+		}
 	case uint8:
-		return x == b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x == y
+		}
 	case uint16:
-		return x == b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x == y
+		}
 	case uint32:
-		return x == b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x == y
+		}
 	case uint64:
-		return x == b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x == y
+		}
 
 	case string:
-		return x == b.(string)
-
-	default:
-		return reflect.DeepEqual(a, b)
+		if y, ok := b.(string); ok {
+			return x == y
+		}
+	}
+	if a == nil || b == nil {
+		return isNil(a) && isNil(b)
 	}
+	if c, ok := compare(a, b); ok {
+		return c == 0
+	}
+	if isNumber(a) && isNumber(b) {
+		return false // NaN is not equal to anything.
+	}
+	if c, ok := compareTime(a, b); ok {
+		return c == 0
+	}
+	return reflect.DeepEqual(a, b)
 }
 
 func less(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x < b.(float32)
+		if y, ok := b.(float32); ok {
+			return x < y
+		}
 	case float64:
-		return x < b.(float64)
+		if y, ok := b.(float64); ok {
+			return x < y
+		}
 
 	case int:
-		return x < b.(int)
+		if y, ok := b.(int); ok {
+			return x < y
+		}
 	case int8:
-		return x < b.(int8)
+		if y, ok := b.(int8); ok {
+			return x < y
+		}
 	case int16:
-		return x < b.(int16)
+		if y, ok := b.(int16); ok {
+			return x < y
+		}
 	case int32:
-		return x < b.(int32)
+		if y, ok := b.(int32); ok {
+			return x < y
+		}
 	case int64:
-		return x < b.(int64)
+		if y, ok := b.(int64); ok {
+			return x < y
+		}
 
 	case uint:
-		return x < b.(uint)
+		if y, ok := b.(uint); ok {
+			return x < y
+		}
 	case uint8:
-		return x < b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x < y
+		}
 	case uint16:
-		return x < b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x < y
+		}
 	case uint32:
-		return x < b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x < y
+		}
 	case uint64:
-		return x < b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x < y
+		}
 
 	case string:
-		return x < b.(string)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T < %T", a, b))
+		if y, ok := b.(string); ok {
+			return x < y
+		}
+	}
+	if c, ok := compare(a, b); ok {
+		return c < 0
+	}
+	if isNumber(a) && isNumber(b) {
+		return false // NaN is not ordered.
 	}
+	if c, ok := compareTime(a, b); ok {
+		return c < 0
+	}
+	panic(newError(TypeMismatch, "invalid operation: %T < %T", a, b))
 }
 
 func more(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x > b.(float32)
+		if y, ok := b.(float32); ok {
+			return x > y
+		}
 	case float64:
-		return x > b.(float64)
+		if y, ok := b.(float64); ok {
+			return x > y
+		}
 
 	case int:
-		return x > b.(int)
+		if y, ok := b.(int); ok {
+			return x > y
+		}
 	case int8:
-		return x > b.(int8)
+		if y, ok := b.(int8); ok {
+			return x > y
+		}
 	case int16:
-		return x > b.(int16)
+		if y, ok := b.(int16); ok {
+			return x > y
+		}
 	case int32:
-		return x > b.(int32)
+		if y, ok := b.(int32); ok {
+			return x > y
+		}
 	case int64:
-		return x > b.(int64)
+		if y, ok := b.(int64); ok {
+			return x > y
+		}
 
 	case uint:
-		return x > b.(uint)
+		if y, ok := b.(uint); ok {
+			return x > y
+		}
 	case uint8:
-		return x > b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x > y
+		}
 	case uint16:
-		return x > b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x > y
+		}
 	case uint32:
-		return x > b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x > y
+		}
 	case uint64:
-		return x > b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x > y
+		}
 
 	case string:
-		return x > b.(string)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T > %T", a, b))
+		if y, ok := b.(string); ok {
+			return x > y
+		}
+	}
+	if c, ok := compare(a, b); ok {
+		return c > 0
+	}
+	if isNumber(a) && isNumber(b) {
+		return false // NaN is not ordered.
 	}
+	if c, ok := compareTime(a, b); ok {
+		return c > 0
+	}
+	panic(newError(TypeMismatch, "invalid operation: %T > %T", a, b))
 }
 
 func lessOrEqual(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x <= b.(float32)
+		if y, ok := b.(float32); ok {
+			return x <= y
+		}
 	case float64:
-		return x <= b.(float64)
+		if y, ok := b.(float64); ok {
+			return x <= y
+		}
 
 	case int:
-		return x <= b.(int)
+		if y, ok := b.(int); ok {
+			return x <= y
+		}
 	case int8:
-		return x <= b.(int8)
+		if y, ok := b.(int8); ok {
+			return x <= y
+		}
 	case int16:
-		return x <= b.(int16)
+		if y, ok := b.(int16); ok {
+			return x <= y
+		}
 	case int32:
-		return x <= b.(int32)
+		if y, ok := b.(int32); ok {
+			return x <= y
+		}
 	case int64:
-		return x <= b.(int64)
+		if y, ok := b.(int64); ok {
+			return x <= y
+		}
 
 	case uint:
-		return x <= b.(uint)
+		if y, ok := b.(uint); ok {
+			return x <= y
+		}
 	case uint8:
-		return x <= b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x <= y
+		}
 	case uint16:
-		return x <= b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x <= y
+		}
 	case uint32:
-		return x <= b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x <= y
+		}
 	case uint64:
-		return x <= b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x <= y
+		}
 
 	case string:
-		return x <= b.(string)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T <= %T", a, b))
+		if y, ok := b.(string); ok {
+			return x <= y
+		}
+	}
+	if c, ok := compare(a, b); ok {
+		return c <= 0
 	}
+	if isNumber(a) && isNumber(b) {
+		return false // NaN is not ordered.
+	}
+	if c, ok := compareTime(a, b); ok {
+		return c <= 0
+	}
+	panic(newError(TypeMismatch, "invalid operation: %T <= %T", a, b))
 }
 
 func moreOrEqual(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x >= b.(float32)
+		if y, ok := b.(float32); ok {
+			return x >= y
+		}
 	case float64:
-		return x >= b.(float64)
+		if y, ok := b.(float64); ok {
+			return x >= y
+		}
 
 	case int:
-		return x >= b.(int)
+		if y, ok := b.(int); ok {
+			return x >= y
+		}
 	case int8:
-		return x >= b.(int8)
+		if y, ok := b.(int8); ok {
+			return x >= y
+		}
 	case int16:
-		return x >= b.(int16)
+		if y, ok := b.(int16); ok {
+			return x >= y
+		}
 	case int32:
-		return x >= b.(int32)
+		if y, ok := b.(int32); ok {
+			return x >= y
+		}
 	case int64:
-		return x >= b.(int64)
+		if y, ok := b.(int64); ok {
+			return x >= y
+		}
 
 	case uint:
-		return x >= b.(uint)
+		if y, ok := b.(uint); ok {
+			return x >= y
+		}
 	case uint8:
-		return x >= b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x >= y
+		}
 	case uint16:
-		return x >= b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x >= y
+		}
 	case uint32:
-		return x >= b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x >= y
+		}
 	case uint64:
-		return x >= b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x >= y
+		}
 
 	case string:
-		return x >= b.(string)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T >= %T", a, b))
+		if y, ok := b.(string); ok {
+			return x >= y
+		}
+	}
+	if c, ok := compare(a, b); ok {
+		return c >= 0
+	}
+	if isNumber(a) && isNumber(b) {
+		return false // NaN is not ordered.
 	}
+	if c, ok := compareTime(a, b); ok {
+		return c >= 0
+	}
+	panic(newError(TypeMismatch, "invalid operation: %T >= %T", a, b))
 }
 
 func add(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x + b.(float32)
+		if y, ok := b.(float32); ok {
+			return x + y
+		}
 	case float64:
-		return x + b.(float64)
+		if y, ok := b.(float64); ok {
+			return x + y
+		}
 
 	case int:
-		return x + b.(int)
+		if y, ok := b.(int); ok {
+			return x + y
+		}
 	case int8:
-		return x + b.(int8)
+		if y, ok := b.(int8); ok {
+			return x + y
+		}
 	case int16:
-		return x + b.(int16)
+		if y, ok := b.(int16); ok {
+			return x + y
+		}
 	case int32:
-		return x + b.(int32)
+		if y, ok := b.(int32); ok {
+			return x + y
+		}
 	case int64:
-		return x + b.(int64)
+		if y, ok := b.(int64); ok {
+			return x + y
+		}
 
 	case uint:
-		return x + b.(uint)
+		if y, ok := b.(uint); ok {
+			return x + y
+		}
 	case uint8:
-		return x + b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x + y
+		}
 	case uint16:
-		return x + b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x + y
+		}
 	case uint32:
-		return x + b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x + y
+		}
 	case uint64:
-		return x + b.(uint64)
+		if y, ok := b.(uint64); ok {
+			return x + y
+		}
 
 	case string:
-		return x + b.(string)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T + %T", a, b))
+		if y, ok := b.(string); ok {
+			return x + y
+		}
+	}
+	if v, ok := timeArithmetic(a, b, "+"); ok {
+		return v
 	}
+	return arithmetic(a, b, "+")
 }
 
 func inc(i interface{}) interface{} {
@@ -427,139 +857,232 @@ func inc(i interface{}) interface{} {
 		return v + 1
 
 	default:
-		panic(fmt.Sprintf("invalid operation: %T + 1", v))
+		panic(newError(TypeMismatch, "invalid operation: %T + 1", v))
 	}
 }
 
 func subtract(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x - b.(float32)
+		if y, ok := b.(float32); ok {
+			return x - y
+		}
 	case float64:
-		return x - b.(float64)
+		if y, ok := b.(float64); ok {
+			return x - y
+		}
 
 	case int:
-		return x - b.(int)
+		if y, ok := b.(int); ok {
+			return x - y
+		}
 	case int8:
-		return x - b.(int8)
+		if y, ok := b.(int8); ok {
+			return x - y
+		}
 	case int16:
-		return x - b.(int16)
+		if y, ok := b.(int16); ok {
+			return x - y
+		}
 	case int32:
-		return x - b.(int32)
+		if y, ok := b.(int32); ok {
+			return x - y
+		}
 	case int64:
-		return x - b.(int64)
+		if y, ok := b.(int64); ok {
+			return x - y
+		}
 
 	case uint:
-		return x - b.(uint)
+		if y, ok := b.(uint); ok {
+			return x - y
+		}
 	case uint8:
-		return x - b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x - y
+		}
 	case uint16:
-		return x - b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x - y
+		}
 	case uint32:
-		return x - b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x - y
+		}
 	case uint64:
-		return x - b.(uint64)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T - %T", a, b))
+		if y, ok := b.(uint64); ok {
+			return x - y
+		}
+	}
+	if v, ok := timeArithmetic(a, b, "-"); ok {
+		return v
 	}
+	return arithmetic(a, b, "-")
 }
 
 func multiply(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x * b.(float32)
+		if y, ok := b.(float32); ok {
+			return x * y
+		}
 	case float64:
-		return x * b.(float64)
+		if y, ok := b.(float64); ok {
+			return x * y
+		}
 
 	case int:
-		return x * b.(int)
+		if y, ok := b.(int); ok {
+			return x * y
+		}
 	case int8:
-		return x * b.(int8)
+		if y, ok := b.(int8); ok {
+			return x * y
+		}
 	case int16:
-		return x * b.(int16)
+		if y, ok := b.(int16); ok {
+			return x * y
+		}
 	case int32:
-		return x * b.(int32)
+		if y, ok := b.(int32); ok {
+			return x * y
+		}
 	case int64:
-		return x * b.(int64)
+		if y, ok := b.(int64); ok {
+			return x * y
+		}
 
 	case uint:
-		return x * b.(uint)
+		if y, ok := b.(uint); ok {
+			return x * y
+		}
 	case uint8:
-		return x * b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x * y
+		}
 	case uint16:
-		return x * b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x * y
+		}
 	case uint32:
-		return x * b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x * y
+		}
 	case uint64:
-		return x * b.(uint64)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T * %T", a, b))
+		if y, ok := b.(uint64); ok {
+			return x * y
+		}
+	}
+	if v, ok := timeArithmetic(a, b, "*"); ok {
+		return v
 	}
+	return arithmetic(a, b, "*")
 }
 
 func divide(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case float32:
-		return x / b.(float32)
+		if y, ok := b.(float32); ok {
+			return x / y
+		}
 	case float64:
-		return x / b.(float64)
+		if y, ok := b.(float64); ok {
+			return x / y
+		}
 
 	case int:
-		return x / b.(int)
+		if y, ok := b.(int); ok {
+			return x / y
+		}
 	case int8:
-		return x / b.(int8)
+		if y, ok := b.(int8); ok {
+			return x / y
+		}
 	case int16:
-		return x / b.(int16)
+		if y, ok := b.(int16); ok {
+			return x / y
+		}
 	case int32:
-		return x / b.(int32)
+		if y, ok := b.(int32); ok {
+			return x / y
+		}
 	case int64:
-		return x / b.(int64)
+		if y, ok := b.(int64); ok {
+			return x / y
+		}
 
 	case uint:
-		return x / b.(uint)
+		if y, ok := b.(uint); ok {
+			return x / y
+		}
 	case uint8:
-		return x / b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x / y
+		}
 	case uint16:
-		return x / b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x / y
+		}
 	case uint32:
-		return x / b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x / y
+		}
 	case uint64:
-		return x / b.(uint64)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T / %T", a, b))
+		if y, ok := b.(uint64); ok {
+			return x / y
+		}
+	}
+	if v, ok := timeArithmetic(a, b, "/"); ok {
+		return v
 	}
+	return arithmetic(a, b, "/")
 }
 
 func modulo(a, b interface{}) interface{} {
 	switch x := a.(type) {
 	case int:
-		return x % b.(int)
+		if y, ok := b.(int); ok {
+			return x % y
+		}
 	case int8:
-		return x % b.(int8)
+		if y, ok := b.(int8); ok {
+			return x % y
+		}
 	case int16:
-		return x % b.(int16)
+		if y, ok := b.(int16); ok {
+			return x % y
+		}
 	case int32:
-		return x % b.(int32)
+		if y, ok := b.(int32); ok {
+			return x % y
+		}
 	case int64:
-		return x % b.(int64)
+		if y, ok := b.(int64); ok {
+			return x % y
+		}
 
 	case uint:
-		return x % b.(uint)
+		if y, ok := b.(uint); ok {
+			return x % y
+		}
 	case uint8:
-		return x % b.(uint8)
+		if y, ok := b.(uint8); ok {
+			return x % y
+		}
 	case uint16:
-		return x % b.(uint16)
+		if y, ok := b.(uint16); ok {
+			return x % y
+		}
 	case uint32:
-		return x % b.(uint32)
+		if y, ok := b.(uint32); ok {
+			return x % y
+		}
 	case uint64:
-		return x % b.(uint64)
-
-	default:
-		panic(fmt.Sprintf("invalid operation: %T %v %T", a, "%", b))
+		if y, ok := b.(uint64); ok {
+			return x % y
+		}
 	}
+	return arithmetic(a, b, "%")
 }
 
 func exponent(a, b interface{}) float64 {
@@ -568,21 +1091,39 @@ func exponent(a, b interface{}) float64 {
 
 func makeRange(a, b interface{}) []int {
 	min := toInt(a)
-	max := toInt(b)
-	size := max - min + 1
-	rng := make([]int, size)
+	rng := make([]int, rangeSize(a, b))
 	for i := range rng {
 		rng[i] = min + i
 	}
 	return rng
 }
 
+// Limits of int, math.MaxInt and math.MinInt are not available before Go 1.17.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// rangeSize returns number of items of range a..b, which is empty if b is
+// less than a, and as large as int allows if the size overflows it.
+func rangeSize(a, b interface{}) int {
+	min, max := toInt(a), toInt(b)
+	if max < min {
+		return 0
+	}
+	size := uint(max-min) + 1
+	if size == 0 || size > uint(maxInt) {
+		return maxInt
+	}
+	return int(size)
+}
+
 func toInt(a interface{}) int {
 	switch x := a.(type) {
 	case float32:
-		return int(x)
+		return floatToInt(float64(x))
 	case float64:
-		return int(x)
+		return floatToInt(x)
 
 	case int:
 		return int(x)
@@ -607,8 +1148,16 @@ func toInt(a interface{}) int {
 		return int(x)
 
 	default:
-		panic(fmt.Sprintf("invalid operation: int(%T)", x))
+		panic(newError(TypeMismatch, "invalid operation: int(%T)", x))
+	}
+}
+
+// floatToInt truncates the float, which must be in int range.
+func floatToInt(f float64) int {
+	if !IsIntRange(f) {
+		panic(newError(IntegerOverflow, "cannot convert %v to int (overflows int)", f))
 	}
+	return int(f)
 }
 
 func toFloat64(a interface{}) float64 {
@@ -641,6 +1190,205 @@ func toFloat64(a interface{}) float64 {
 		return float64(x)
 
 	default:
-		panic(fmt.Sprintf("invalid operation: float64(%T)", x))
+		panic(newError(TypeMismatch, "invalid operation: float64(%T)", x))
+	}
+}
+
+// Operands of different numeric types are promoted before arithmetic and
+// comparison: to float64 if any of them is a float, to uint64 if both are
+// unsigned, and to int64 otherwise. Operands of the same type are used as is.
+
+// number converts value of any numeric kind (including named numeric types)
+// to int64, uint64 or float64.
+func number(v interface{}) (interface{}, bool) {
+	switch x := v.(type) {
+	case int:
+		return int64(x), true
+	case int8:
+		return int64(x), true
+	case int16:
+		return int64(x), true
+	case int32:
+		return int64(x), true
+	case int64:
+		return x, true
+	case uint:
+		return uint64(x), true
+	case uint8:
+		return uint64(x), true
+	case uint16:
+		return uint64(x), true
+	case uint32:
+		return uint64(x), true
+	case uint64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case float64:
+		return x, true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return nil, false
+}
+
+func isNumber(v interface{}) bool {
+	_, ok := number(v)
+	return ok
+}
+
+// compare returns -1, 0 or 1 if a is less, equal or greater than b.
+// It returns false if any of operands is not a number or is NaN.
+// Signed and unsigned integers are compared without overflow.
+func compare(a, b interface{}) (int, bool) {
+	x, ok := number(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := number(b)
+	if !ok {
+		return 0, false
+	}
+
+	switch x := x.(type) {
+	case int64:
+		switch y := y.(type) {
+		case int64:
+			return compareInt(x, y), true
+		case uint64:
+			if x < 0 {
+				return -1, true
+			}
+			return compareUint(uint64(x), y), true
+		case float64:
+			return compareFloat(float64(x), y)
+		}
+
+	case uint64:
+		switch y := y.(type) {
+		case int64:
+			if y < 0 {
+				return 1, true
+			}
+			return compareUint(x, uint64(y)), true
+		case uint64:
+			return compareUint(x, y), true
+		case float64:
+			return compareFloat(float64(x), y)
+		}
+
+	case float64:
+		return compareFloat(x, toFloat64(y))
+	}
+	return 0, false
+}
+
+func compareInt(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareUint(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(x, y float64) (int, bool) {
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	case x == y:
+		return 0, true
+	}
+	return 0, false
+}
+
+// arithmetic applies operator to operands of different numeric types.
+func arithmetic(a, b interface{}, op string) interface{} {
+	x, ok1 := number(a)
+	y, ok2 := number(b)
+	if !ok1 || !ok2 {
+		panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+	}
+
+	_, xf := x.(float64)
+	_, yf := y.(float64)
+	_, xu := x.(uint64)
+	_, yu := y.(uint64)
+
+	switch {
+	case xf || yf:
+		x, y := toFloat64(x), toFloat64(y)
+		switch op {
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		case "/":
+			return x / y
+		}
+
+	case xu && yu:
+		x, y := x.(uint64), y.(uint64)
+		switch op {
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		case "/":
+			return x / y
+		case "%":
+			return x % y
+		}
+
+	default:
+		x, y := toInt64(x), toInt64(y)
+		switch op {
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		case "/":
+			return x / y
+		case "%":
+			return x % y
+		}
+	}
+	panic(newError(TypeMismatch, "invalid operation: %T %v %T", a, op, b))
+}
+
+func toInt64(a interface{}) int64 {
+	switch x := a.(type) {
+	case int64:
+		return x
+	case uint64:
+		return int64(x)
 	}
+	return int64(toInt(a))
 }
@@ -0,0 +1,95 @@
+package vm
+
+import (
+	"time"
+)
+
+// Times can be compared, and moved by durations. Durations are numbers,
+// but keep their type in arithmetic with other durations and integers.
+
+// timeArithmetic applies operator to times and durations. It reports
+// false if operands are not a time and a duration, or a duration and
+// a number.
+func timeArithmetic(a, b interface{}, op string) (interface{}, bool) {
+	switch x := a.(type) {
+	case time.Time:
+		switch y := b.(type) {
+		case time.Duration:
+			switch op {
+			case "+":
+				return x.Add(y), true
+			case "-":
+				return x.Add(-y), true
+			}
+		case time.Time:
+			if op == "-" {
+				return x.Sub(y), true
+			}
+		}
+
+	case time.Duration:
+		if y, ok := b.(time.Time); ok && op == "+" {
+			return y.Add(x), true
+		}
+		return durationArithmetic(x, b, op, false)
+	}
+
+	if y, ok := b.(time.Duration); ok {
+		return durationArithmetic(y, a, op, true)
+	}
+	return nil, false
+}
+
+// durationArithmetic applies operator to the duration and the number,
+// which is the left operand if swapped is true.
+func durationArithmetic(d time.Duration, v interface{}, op string, swapped bool) (interface{}, bool) {
+	n, ok := number(v)
+	if !ok {
+		return nil, false
+	}
+	if f, ok := n.(float64); ok {
+		if op == "*" {
+			return time.Duration(float64(d) * f), true
+		}
+		if op == "/" && !swapped {
+			return time.Duration(float64(d) / f), true
+		}
+		return nil, false
+	}
+
+	x, y := int64(d), toInt64(n)
+	if swapped {
+		x, y = y, x
+	}
+	switch op {
+	case "+":
+		return time.Duration(x + y), true
+	case "-":
+		return time.Duration(x - y), true
+	case "*":
+		return time.Duration(x * y), true
+	case "/":
+		return time.Duration(x / y), true
+	}
+	return nil, false
+}
+
+// compareTime returns -1, 0 or 1 if time a is before, equal or after time b.
+// It returns false if any of operands is not a time.
+func compareTime(a, b interface{}) (int, bool) {
+	x, ok := a.(time.Time)
+	if !ok {
+		return 0, false
+	}
+	y, ok := b.(time.Time)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case x.Before(y):
+		return -1, true
+	case x.After(y):
+		return 1, true
+	}
+	return 0, true
+}
@@ -7,52 +7,85 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 
-	"github.com/jakub-gawlas/expr/internal/helper"
+	"github.com/jakub-gawlas/expr/file"
 )
 
-func Run(program *Program, env interface{}, ctx context.Context) (out interface{}, err error) {
-	vm := NewVM(false, ctx)
+var pool = sync.Pool{
+	New: func() interface{} {
+		return NewVM(false, nil)
+	},
+}
 
-	defer func() {
-		if r := recover(); r != nil {
-			h := helper.Error{
-				Location: program.Locations[vm.pp],
-				Message:  fmt.Sprintf("%v", r),
-			}
-			err = fmt.Errorf("%v", h.Format(program.Source))
-		}
-	}()
+// Run evaluates program with a VM taken from the pool of reusable VMs.
+func Run(program *Program, env interface{}, ctx context.Context, ops ...RunOption) (interface{}, error) {
+	vm := pool.Get().(*VM)
+	vm.SetContext(ctx)
+	vm.budget = budget{}
+	for _, op := range ops {
+		op(vm)
+	}
 
-	vm.SetProgram(program)
-	vm.SetEnv(env)
-	out, err = vm.Run()
-	return
+	out, err := vm.RunProgram(program, env)
+
+	// Closures passed to Go funcs hold the VM, and may be called after
+	// the run by mistake, so such VM is not reused by other runs.
+	escaped := vm.closures
+	vm.Reset()
+	// Pooled VM must not keep the context, the program and its source alive.
+	vm.SetContext(nil)
+	if !escaped {
+		pool.Put(vm)
+	}
+	return out, err
 }
 
+// checkInterval is a number of instructions after which VM checks
+// if context is done. Must be a power of two.
+const checkInterval = 1024
+
 type VM struct {
 	env       interface{}
 	stack     []interface{}
 	bytecode  []byte
 	ip        int
 	pp        int
+	steps     int
+	allocated int
 	constants []interface{}
+	locations []file.Location
+	source    *file.Source
 	scopes    []Scope
+	slots     []interface{}
 	debug     bool
 	step      chan struct{}
 	curr      chan int
-	ctx       reflect.Value
+	ctx       context.Context
+	done      <-chan struct{}
+	// generation is incremented by Reset, so closures passed to Go funcs
+	// can tell that the run which created them has finished.
+	generation int
+	closures   bool
+	budget
 }
 
-func NewVM(debug bool, ctx context.Context) *VM {
-	var ctxVal = reflect.ValueOf(ctx)
-	if ctx == nil {
-		ctxVal = reflect.ValueOf(&ctx).Elem()
-	}
+type budget struct {
+	maxSteps       int
+	maxStack       int
+	maxRange       int
+	maxAllocations int
+	maxString      int
+}
+
+func NewVM(debug bool, ctx context.Context, ops ...RunOption) *VM {
 	vm := &VM{
 		stack: make([]interface{}, 0, 2),
 		debug: debug,
-		ctx:   ctxVal,
+	}
+	vm.SetContext(ctx)
+	for _, op := range ops {
+		op(vm)
 	}
 	if vm.debug {
 		vm.step = make(chan struct{}, 0)
@@ -61,9 +94,60 @@ func NewVM(debug bool, ctx context.Context) *VM {
 	return vm
 }
 
+// RunProgram resets VM and evaluates program with env.
+// Unlike Run, failures occurred during evaluation are returned as *RuntimeError.
+func (vm *VM) RunProgram(program *Program, env interface{}) (out interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = vm.runtimeError(r)
+		}
+	}()
+
+	vm.Reset()
+	vm.SetProgram(program)
+	vm.SetEnv(env)
+	return vm.Run()
+}
+
+// Reset clears state left by previous evaluation and the program, so VM
+// can be reused. Allocated stack and scopes are kept for the next run,
+// as well as the context and the budget.
+func (vm *VM) Reset() {
+	for i := range vm.stack {
+		vm.stack[i] = nil
+	}
+	vm.stack = vm.stack[:0]
+	vm.scopes = vm.scopes[:0]
+	for i := range vm.slots {
+		vm.slots[i] = nil
+	}
+	vm.slots = vm.slots[:0]
+	vm.env = nil
+	vm.bytecode = nil
+	vm.constants = nil
+	vm.locations = nil
+	vm.source = nil
+	vm.ip = 0
+	vm.pp = 0
+	vm.steps = 0
+	vm.allocated = 0
+	vm.generation++
+	vm.closures = false
+}
+
+func (vm *VM) SetContext(ctx context.Context) {
+	vm.ctx = ctx
+	vm.done = nil
+	if ctx != nil {
+		vm.done = ctx.Done()
+	}
+}
+
 func (vm *VM) SetProgram(program *Program) {
 	vm.bytecode = program.Bytecode
 	vm.constants = program.Constants
+	vm.locations = program.Locations
+	vm.source = program.Source
 }
 
 func (vm *VM) SetEnv(env interface{}) {
@@ -71,6 +155,29 @@ func (vm *VM) SetEnv(env interface{}) {
 }
 
 func (vm *VM) Run() (interface{}, error) {
+	if err := vm.run(); err != nil {
+		return nil, err
+	}
+
+	if vm.debug {
+		close(vm.curr)
+		close(vm.step)
+	}
+
+	if len(vm.stack) > 0 {
+		out := vm.pop()
+		if shared(vm.constants) {
+			// Constants are pushed without copying, so the result may hold them.
+			out = clone(out)
+		}
+		return out, nil
+	}
+
+	return nil, nil
+}
+
+// run evaluates bytecode starting at ip, until the end of it or OpReturn.
+func (vm *VM) run() error {
 	for vm.ip < len(vm.bytecode) {
 
 		if vm.debug {
@@ -81,6 +188,20 @@ func (vm *VM) Run() (interface{}, error) {
 		vm.ip++
 		op := vm.bytecode[vm.pp]
 
+		// Loops are the only way for program to run long,
+		// so check context on every backward jump as well.
+		if vm.done != nil && (op == OpJumpBackward || vm.steps&(checkInterval-1) == 0) {
+			select {
+			case <-vm.done:
+				return vm.contextError()
+			default:
+			}
+		}
+		vm.steps++
+		if vm.maxSteps > 0 && vm.steps > vm.maxSteps {
+			return vm.budgetError(InstructionsLimit, vm.maxSteps)
+		}
+
 		switch op {
 
 		case OpPush:
@@ -90,7 +211,11 @@ func (vm *VM) Run() (interface{}, error) {
 			vm.pop()
 
 		case OpConst:
-			vm.push(vm.constants[vm.arg()])
+			value := vm.constants[vm.arg()]
+			if err := vm.allocate(allocations(value)); err != nil {
+				return err
+			}
+			vm.push(value)
 
 		case OpFetch:
 			vm.push(fetch(vm.env, vm.constants[vm.arg()]))
@@ -105,12 +230,23 @@ func (vm *VM) Run() (interface{}, error) {
 			vm.push(false)
 
 		case OpNil:
-			vm.push(new(struct{}))
+			vm.push(nil)
 
 		case OpNegate:
 			v := negate(vm.pop())
 			vm.push(v)
 
+		case OpBitNot:
+			v := complement(vm.pop())
+			vm.push(v)
+
+		case OpNotEmpty:
+			// Array is left on the stack for the loop of the builtin.
+			name := vm.constants[vm.arg()]
+			if v := reflect.ValueOf(vm.current()); v.Kind() == reflect.Slice && v.Len() == 0 {
+				panic(newError(EmptyArray, "%v of empty array", name))
+			}
+
 		case OpNot:
 			v := vm.pop().(bool)
 			vm.push(!v)
@@ -145,6 +281,20 @@ func (vm *VM) Run() (interface{}, error) {
 			offset := vm.arg()
 			vm.ip -= int(offset)
 
+		case OpJumpIfNil:
+			offset := vm.arg()
+			if isNil(vm.current()) {
+				vm.pop()
+				vm.push(nil)
+				vm.ip += int(offset)
+			}
+
+		case OpJumpIfNotNil:
+			offset := vm.arg()
+			if !isNil(vm.current()) {
+				vm.ip += int(offset)
+			}
+
 		case OpIn:
 			b := vm.pop()
 			a := vm.pop()
@@ -204,9 +354,46 @@ func (vm *VM) Run() (interface{}, error) {
 			a := vm.pop()
 			vm.push(exponent(a, b))
 
+		case OpBitAnd:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(bitwise(a, b, "&"))
+
+		case OpBitOr:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(bitwise(a, b, "|"))
+
+		case OpBitXor:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(bitwise(a, b, "^"))
+
+		case OpBitAndNot:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(bitwise(a, b, "&^"))
+
+		case OpShiftLeft:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(shift(a, b, "<<"))
+
+		case OpShiftRight:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(shift(a, b, ">>"))
+
 		case OpRange:
 			b := vm.pop()
 			a := vm.pop()
+			size := rangeSize(a, b)
+			if vm.maxRange > 0 && size > vm.maxRange {
+				return vm.budgetError(RangeSizeLimit, vm.maxRange)
+			}
+			if err := vm.allocate(size); err != nil {
+				return err
+			}
 			vm.push(makeRange(a, b))
 
 		case OpMatches:
@@ -214,11 +401,121 @@ func (vm *VM) Run() (interface{}, error) {
 			a := vm.pop()
 			match, err := regexp.MatchString(b.(string), a.(string))
 			if err != nil {
-				panic(err)
+				panic(newError(InvalidRegexp, "%v", err))
 			}
 
 			vm.push(match)
 
+		case OpInSet:
+			a := vm.pop()
+			set := vm.constants[vm.arg()].(Set)
+			vm.push(set.Contains(a))
+
+		case OpInRange:
+			a := vm.pop()
+			rng := vm.constants[vm.arg()].(Range)
+			vm.push(rng.Contains(a))
+
+		case OpEqualInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a == b)
+
+		case OpLessInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a < b)
+
+		case OpMoreInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a > b)
+
+		case OpLessOrEqualInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a <= b)
+
+		case OpMoreOrEqualInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a >= b)
+
+		case OpAddInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a + b)
+
+		case OpSubtractInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a - b)
+
+		case OpMultiplyInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a * b)
+
+		case OpDivideInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a / b)
+
+		case OpModuloInt:
+			b := vm.pop().(int)
+			a := vm.pop().(int)
+			vm.push(a % b)
+
+		case OpEqualFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a == b)
+
+		case OpLessFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a < b)
+
+		case OpMoreFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a > b)
+
+		case OpLessOrEqualFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a <= b)
+
+		case OpMoreOrEqualFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a >= b)
+
+		case OpAddFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a + b)
+
+		case OpSubtractFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a - b)
+
+		case OpMultiplyFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a * b)
+
+		case OpDivideFloat:
+			b := vm.pop().(float64)
+			a := vm.pop().(float64)
+			vm.push(a / b)
+
+		case OpAddString:
+			b := vm.pop().(string)
+			a := vm.pop().(string)
+			vm.push(a + b)
+
 		case OpMatchesConst:
 			a := vm.pop()
 			r := vm.constants[vm.arg()].(*regexp.Regexp)
@@ -249,6 +546,42 @@ func (vm *VM) Run() (interface{}, error) {
 			b := vm.constants[vm.arg()]
 			vm.push(fetch(a, b))
 
+		case OpStoreSlot:
+			slot := int(vm.arg())
+			for len(vm.slots) <= slot {
+				vm.slots = append(vm.slots, nil)
+			}
+			vm.slots[slot] = vm.pop()
+
+		case OpLoadSlot:
+			vm.push(vm.slots[vm.arg()])
+
+		case OpIndexOrNil:
+			b := vm.pop()
+			a := vm.pop()
+			vm.push(fetchOrNil(a, b))
+
+		case OpPropertyOrNil:
+			a := vm.pop()
+			b := vm.constants[vm.arg()]
+			vm.push(fetchOrNil(a, b))
+
+		case OpFetchOrNil:
+			key := vm.constants[vm.arg()]
+			if vm.env == nil {
+				vm.push(nil)
+			} else {
+				vm.push(fetchOrNil(vm.env, key))
+			}
+
+		case OpFunction:
+			skip := int(vm.arg())
+			vm.push(Function{IP: vm.ip, Arity: vm.pop().(int)})
+			vm.ip += skip
+
+		case OpReturn:
+			return nil
+
 		case OpCall:
 			call := vm.constants[vm.arg()].(Call)
 
@@ -272,10 +605,10 @@ func (vm *VM) Run() (interface{}, error) {
 
 			in := make([]reflect.Value, size)
 			for i := size - 1; i >= stopAt; i-- {
-				in[i] = reflect.ValueOf(vm.pop())
+				in[i] = vm.argument(vm.pop(), paramType(fnType, i))
 			}
 			if passCtx {
-				in[0] = vm.ctx
+				in[0] = reflect.ValueOf(&vm.ctx).Elem()
 			}
 
 			out := fn.Call(in)
@@ -291,28 +624,66 @@ func (vm *VM) Run() (interface{}, error) {
 				err, ok = Error(out[1])
 			}
 			if ok && err != nil {
-				return nil, err
+				return err
 			}
 
 			if len(out) > 0 {
 				vm.push(out[0].Interface())
 			}
 
+		case OpBuiltin:
+			call := vm.constants[vm.arg()].(BuiltinCall)
+
+			args := make([]interface{}, call.Size)
+			for i := call.Size - 1; i >= 0; i-- {
+				args[i] = vm.pop()
+				if f, ok := args[i].(Function); ok {
+					args[i] = vm.argument(f, closureType).Interface()
+				}
+			}
+
+			if vm.maxString > 0 && call.StringSize != nil && call.StringSize(args...) > vm.maxString {
+				return vm.budgetError(StringSizeLimit, vm.maxString)
+			}
+			out, err := call.Func(args...)
+			if e, ok := err.(*BudgetExceeded); ok {
+				return vm.budgetError(e.Limit, e.Max)
+			}
+			if err != nil {
+				return vm.runtimeError(err)
+			}
+			if s, ok := out.(string); ok && vm.maxString > 0 && len(s) > vm.maxString {
+				return vm.budgetError(StringSizeLimit, vm.maxString)
+			}
+			if err := vm.allocate(allocations(out)); err != nil {
+				return err
+			}
+			vm.push(out)
+
 		case OpMethod:
 			call := vm.constants[vm.arg()].(Call)
 
-			in := make([]reflect.Value, call.Size)
+			args := make([]interface{}, call.Size)
 			for i := call.Size - 1; i >= 0; i-- {
-				in[i] = reflect.ValueOf(vm.pop())
+				args[i] = vm.pop()
 			}
 
 			obj := vm.pop()
 
-			out := fetchFn(obj, call.Name).Call(in)
+			fn := fetchFn(obj, call.Name)
+			in := make([]reflect.Value, call.Size)
+			for i, arg := range args {
+				in[i] = vm.argument(arg, paramType(fn.Type(), i))
+			}
+
+			out := fn.Call(in)
 			vm.push(out[0].Interface())
 
 		case OpArray:
 			size := vm.pop().(int)
+			if err := vm.allocate(size); err != nil {
+				return err
+			}
 			array := make([]interface{}, size)
 			for i := size - 1; i >= 0; i-- {
 				array[i] = vm.pop()
@@ -321,6 +692,9 @@ func (vm *VM) Run() (interface{}, error) {
 
 		case OpMap:
 			size := vm.pop().(int)
+			if err := vm.allocate(size); err != nil {
+				return err
+			}
 			m := make(map[string]interface{})
 			for i := size - 1; i >= 0; i-- {
 				value := vm.pop()
@@ -330,11 +704,19 @@ func (vm *VM) Run() (interface{}, error) {
 			vm.push(m)
 
 		case OpLen:
-			vm.push(length(vm.current()))
+			vm.push(length(vm.pop()))
 
 		case OpBegin:
-			sc := make(Scope)
-			vm.scopes = append(vm.scopes, sc)
+			// Reuse scopes left from previous runs to avoid allocations.
+			if n := len(vm.scopes); n < cap(vm.scopes) {
+				vm.scopes = vm.scopes[:n+1]
+				sc := vm.scopes[n]
+				for k := range sc {
+					delete(sc, k)
+				}
+			} else {
+				vm.scopes = append(vm.scopes, make(Scope))
+			}
 
 		case OpEnd:
 			vm.scopes = vm.scopes[:len(vm.scopes)-1]
@@ -354,21 +736,65 @@ func (vm *VM) Run() (interface{}, error) {
 			panic(fmt.Sprintf("unknown bytecode %#x", op))
 		}
 
+		if vm.maxStack > 0 && len(vm.stack) > vm.maxStack {
+			return vm.budgetError(StackSizeLimit, vm.maxStack)
+		}
+
 		if vm.debug {
 			vm.curr <- vm.ip
 		}
 	}
 
-	if vm.debug {
-		close(vm.curr)
-		close(vm.step)
+	return nil
+}
+
+// argument converts value to the argument of type t,
+// functions are converted to Go funcs calling them. The funcs are valid
+// only during the run, later calls panic with a *RuntimeError.
+func (vm *VM) argument(value interface{}, t reflect.Type) reflect.Value {
+	if f, ok := value.(Function); ok && t.Kind() == reflect.Func {
+		vm.closures = true
+		generation := vm.generation
+		return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+			if vm.generation != generation {
+				panic(newError(UnknownError, "closure called after the program has finished"))
+			}
+			return vm.call(f, t, args)
+		})
+	}
+	return argument(value, t)
+}
+
+// call evaluates body of the function with args, and returns its result.
+// The function can be called only while the program is running.
+func (vm *VM) call(f Function, t reflect.Type, args []reflect.Value) []reflect.Value {
+	if t.IsVariadic() {
+		variadic := args[len(args)-1]
+		args = args[: len(args)-1 : len(args)-1]
+		for i := 0; i < variadic.Len(); i++ {
+			args = append(args, variadic.Index(i))
+		}
+	}
+	for i := 0; i < f.Arity; i++ {
+		if i < len(args) {
+			vm.push(args[i].Interface())
+		} else {
+			vm.push(nil)
+		}
 	}
 
-	if len(vm.stack) > 0 {
-		return vm.pop(), nil
+	ip, pp := vm.ip, vm.pp
+	vm.ip = f.IP
+	if err := vm.run(); err != nil {
+		panic(err)
 	}
+	vm.ip, vm.pp = ip, pp
 
-	return nil, nil
+	out := vm.pop()
+	if t.NumOut() == 0 {
+		return nil
+	}
+	return []reflect.Value{result(out, t.Out(0))}
 }
 
 func (vm *VM) push(value interface{}) {
@@ -391,6 +817,66 @@ func (vm *VM) arg() uint16 {
 	return arg
 }
 
+func (vm *VM) location() file.Location {
+	if vm.pp < len(vm.locations) {
+		return vm.locations[vm.pp]
+	}
+	return file.Location{}
+}
+
+func (vm *VM) snippet(location file.Location) string {
+	h := file.Error{Location: location}
+	return h.Bind(vm.source).Snippet
+}
+
+func (vm *VM) runtimeError(r interface{}) error {
+	// Errors of funcs passed to Go are propagated as panics.
+	switch e := r.(type) {
+	case *ContextError:
+		return e
+	case *BudgetExceeded:
+		return e
+	}
+
+	e, ok := r.(*RuntimeError)
+	if !ok {
+		e = &RuntimeError{
+			Kind:    errorKind(r),
+			Message: fmt.Sprintf("%v", r),
+		}
+	}
+	e.Location = vm.location()
+	e.Snippet = vm.snippet(e.Location)
+	return e
+}
+
+func (vm *VM) contextError() error {
+	location := vm.location()
+	return &ContextError{
+		Err:      vm.ctx.Err(),
+		Location: location,
+		Snippet:  vm.snippet(location),
+	}
+}
+
+func (vm *VM) allocate(size int) error {
+	vm.allocated += size
+	if vm.maxAllocations > 0 && vm.allocated > vm.maxAllocations {
+		return vm.budgetError(AllocationsLimit, vm.maxAllocations)
+	}
+	return nil
+}
+
+func (vm *VM) budgetError(limit Limit, max int) error {
+	location := vm.location()
+	return &BudgetExceeded{
+		Limit:    limit,
+		Max:      max,
+		Location: location,
+		Snippet:  vm.snippet(location),
+	}
+}
+
 func (vm *VM) Stack() []interface{} {
 	return vm.stack
 }
@@ -1,7 +1,12 @@
 package vm_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -137,6 +142,10 @@ func TestRun(t *testing.T) {
 			`String matches ("^" + String + "$")`,
 			true,
 		},
+		{
+			"[\"v1.20\" matches `^v\\d+\\.\\d+$`, \"v1x20\" matches \"^v\\\\d+\\\\.\\\\d+$\"]",
+			[]interface{}{true, false},
+		},
 		{
 			`"foobar" contains "bar"`,
 			true,
@@ -153,6 +162,22 @@ func TestRun(t *testing.T) {
 			`(0..10)[5]`,
 			5,
 		},
+		{
+			`Int..-1`,
+			[]int{},
+		},
+		{
+			`len(5..1)`,
+			0,
+		},
+		{
+			`len(Int..Int)`,
+			1,
+		},
+		{
+			`map(5..1, {#})`,
+			[]interface{}{},
+		},
 		{
 			`Ticket.Price`,
 			100,
@@ -201,6 +226,10 @@ func TestRun(t *testing.T) {
 			`len(Array)`,
 			5,
 		},
+		{
+			`len({a: 1, b: 2})`,
+			2,
+		},
 		{
 			`filter(1..9, {# > 7})`,
 			[]interface{}{8, 9},
@@ -237,6 +266,110 @@ func TestRun(t *testing.T) {
 			`Now.Sub(Now).String() == Duration("0s").String()`,
 			true,
 		},
+		{
+			`String in ["a", "string"] and Int64 not in [1, 2] and Float64 in [0, 0.5] and Any in ["any"]`,
+			true,
+		},
+		{
+			`Int + 1 == 1 and Float64 * 2.5 < 1 and String + "s" == "strings" and 7 % (Int + 4) == 3 and (Int - 9) / 3 == -3`,
+			true,
+		},
+		{
+			`Int in 0..1000000 and Uint64 in 0..1 and Int not in 1..2 and Any not in 0..1`,
+			true,
+		},
+		{
+			`Level in [1, 2] and Level in 1..2 and Level not in [3, 4] and Level not in 3..4`,
+			true,
+		},
+		{
+			`let x = 2; let y = x * 3; x + y`,
+			8,
+		},
+		{
+			`let total = Ticket.Price * 2; total > 100 and total < 1000`,
+			true,
+		},
+		{
+			`let String = "shadowed"; String + " " + (let String = 1; String + 1 == 2 ? "twice" : "")`,
+			"shadowed twice",
+		},
+		{
+			`let n = 3; filter(Array, {# > n})`,
+			[]interface{}{4, 5},
+		},
+		{
+			`map(Array, {let x = # * 2; x + 1})`,
+			[]interface{}{3, 5, 7, 9, 11},
+		},
+		{
+			`map(Array, x => x * 10)`,
+			[]interface{}{10, 20, 30, 40, 50},
+		},
+		{
+			`filter(Array, (x, i) => i % 2 == 0)`,
+			[]interface{}{1, 3, 5},
+		},
+		{
+			`map(Array, x => len(filter(Array, y => y < x)))`,
+			[]interface{}{0, 1, 2, 3, 4},
+		},
+		{
+			`map(Array, x => one(Array, {# == x}))`,
+			[]interface{}{true, true, true, true, true},
+		},
+		{
+			`map([{id: 2, items: [1, 2, 2]}], o => len(filter(o.items, i => i == o.id)))`,
+			[]interface{}{2},
+		},
+		{
+			`sum(Array)`,
+			15,
+		},
+		{
+			`sum(map(Array, {# * 1.5}))`,
+			22.5,
+		},
+		{
+			`sum(Array, {# * 2}) + sum([])`,
+			30,
+		},
+		{
+			`count(Array, {# > 2})`,
+			3,
+		},
+		{
+			`min(Array) + max(Array, {-#})`,
+			0,
+		},
+		{
+			`[min(["b", "a", "c"]), max(["b", "a", "c"])]`,
+			[]interface{}{"a", "c"},
+		},
+		{
+			`avg(Array)`,
+			3.0,
+		},
+		{
+			`reduce(Array, {#acc + #}, 0) == reduce(Array, (x, i) => #acc + x)`,
+			true,
+		},
+		{
+			`reduce(Array, {#acc * 10 + reduce(Array, {#acc}, #)}, 0)`,
+			12345,
+		},
+		{
+			`map(Array, x => reduce(Array, {# < x ? #acc + # : #acc}, 0))`,
+			[]interface{}{0, 1, 3, 6, 10},
+		},
+		{
+			`reduce(["a", "b", "c"], {#acc + "," + #})`,
+			"a,b,c",
+		},
+		{
+			`reduce([], {#acc}) ?? sum([])`,
+			0,
+		},
 	}
 
 	env := &mockEnv{
@@ -254,6 +387,7 @@ func TestRun(t *testing.T) {
 		},
 		BirthDay: time.Date(2017, time.October, 23, 18, 30, 0, 0, time.UTC),
 		Now:      time.Now(),
+		Level:    2,
 	}
 
 	for _, test := range tests {
@@ -273,6 +407,760 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestRun_func(t *testing.T) {
+	type test struct {
+		input  string
+		output interface{}
+	}
+	var tests = []test{
+		{`SortBy(Array, {-#})`, []int{5, 4, 3, 2, 1}},
+		{`SortBy(Array, x => x % 3)`, []int{3, 1, 4, 2, 5}},
+		{`Fold(Array, 0, (acc, x) => acc + x)`, 15},
+		{`Fold(Array, 1, (acc, x) => acc * x)`, 120},
+		{`Apply(2, {# * 1.5})`, 3.0},
+		{`Apply(2, x => x)`, 2.0},
+		{`Ticket.Apply({.Price + 1})`, 101},
+		{`map(Array, x => Fold(Array, 0, (acc, y) => y < x ? acc + y : acc))`, []interface{}{0, 1, 3, 6, 10}},
+		{`map(Array, {Apply(# * 1.0, x => x * 2)})`, []interface{}{2.0, 4.0, 6.0, 8.0, 10.0}},
+		{`Fold(SortBy(Array, {-#}), 0, (acc, x) => acc * 10 + Fold(Array, x, (a, b) => a))`, 54321},
+		{`let n = 10; Fold(Array, 0, (acc, x) => acc + x * n)`, 150},
+	}
+
+	env := &mockEnv{
+		Array:  []int{1, 2, 3, 4, 5},
+		Ticket: &mockTicket{Price: 100},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		output, err := vm.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+type age int
+
+func TestRun_numeric(t *testing.T) {
+	type test struct {
+		input  string
+		output interface{}
+	}
+	var tests = []test{
+		{`i64 == -1`, true},
+		{`i8 + i64`, int64(0)},
+		{`u * 2`, int64(4)},
+		{`u + u64`, uint64(1)},
+		{`u64 > i64 and i64 < u64`, true},
+		{`u64 == -1`, false},
+		{`f32 + f`, float64(2)},
+		{`i8 / f < 1`, true},
+		{`i8 == 1.0 and 1.0 == i8`, true},
+		{`u % 3`, int64(2)},
+		{`a + 1`, int64(4)},
+		{`a == 3 and a > 2.5`, true},
+		{`i64 in [-1, 2]`, true},
+		{`u64 == 18446744073709551615 and u64 > 9223372036854775807`, true},
+		{`i64 > -9223372036854775808`, true},
+		{`2.5e-3 * 1e3`, 2.5},
+		{`0b1010 + 0o17 + 0xf`, 40},
+	}
+
+	env := map[string]interface{}{
+		"i8":  int8(1),
+		"i64": int64(-1),
+		"u":   uint(2),
+		"u64": uint64(math.MaxUint64),
+		"f32": float32(0.5),
+		"f":   1.5,
+		"a":   age(3),
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		output, err := vm.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.output, output, test.input)
+	}
+}
+
+func TestToInt_overflow(t *testing.T) {
+	assert.Equal(t, -3, vm.ToInt(-3.9))
+	assert.Equal(t, math.MinInt64, vm.ToInt(float64(math.MinInt64)))
+
+	for _, f := range []interface{}{1e20, -1e20, float64(math.MaxInt64), math.NaN(), float32(math.Inf(1))} {
+		func() {
+			defer func() {
+				e, ok := recover().(*vm.RuntimeError)
+				require.True(t, ok, "%v", f)
+				assert.Equal(t, vm.IntegerOverflow, e.Kind, "%v", f)
+			}()
+			vm.ToInt(f)
+		}()
+	}
+}
+
+type flags uint8
+
+func TestRun_bitwise(t *testing.T) {
+	type env struct {
+		Flags flags
+		Int   int
+		Int8  int8
+		Uint  uint64
+		Shift int
+	}
+	var tests = []struct {
+		input  string
+		output interface{}
+	}{
+		{`Flags & 0x4 != 0`, true},
+		{`Flags & 0x2 != 0`, false},
+		{`Flags | 0x2`, flags(0x7)},
+		{`Flags ^ 0xF`, flags(0xA)},
+		{`Flags &^ 1`, flags(0x4)},
+		{`~Flags`, flags(0xFA)},
+		{`Flags << 6`, flags(0x40)},
+		{`1 << 10`, 1024},
+		{`1 << Shift`, 8},
+		{`~0`, -1},
+		{`Int8 >> 1`, int8(-4)},
+		{`Uint >> 60`, uint64(0xF)},
+		{`Int & 0xFF | 0x100`, 0x134},
+		{`Int & Int8`, int64(0x1230)},
+		{`1 + 2 << 3`, 17},
+	}
+
+	values := env{
+		Flags: 0x5,
+		Int:   0x1234,
+		Int8:  -7,
+		Uint:  math.MaxUint64,
+		Shift: 3,
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = checker.Check(tree, checker.Env(env{}))
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		output, err := vm.Run(program, values, nil)
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.output, output, test.input)
+	}
+
+	tree, err := parser.Parse(`1 << Int8`)
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	_, err = vm.Run(program, values, nil)
+	assert.EqualError(t, err, "negative shift amount (-7) (1:3)\n | 1 << Int8\n | ..^")
+}
+
+func TestRun_nil(t *testing.T) {
+	type test struct {
+		input  string
+		output interface{}
+	}
+	var tests = []test{
+		{`nil`, nil},
+		{`nil == nil`, true},
+		{`Any == nil`, true},
+		{`Ticket == nil`, true},
+		{`nil != Ticket`, false},
+		{`Ticket?.Price`, nil},
+		{`Ticket?.Price == nil`, true},
+		{`Ticket?.String()`, nil},
+		{`Ticket?.String().Foo`, nil},
+		{`[Ticket?.Price, 1][1]`, 1},
+		{`Array == nil`, true},
+		{`Ticket?.Price ?? 0`, 0},
+		{`Ticket.Price ?? 0`, 0},
+		{`Any ?? "default"`, "default"},
+		{`{a: 1}.b ?? 2`, 2},
+		{`{a: nil}.a ?? 2`, 2},
+		{`{a: {}}.a.b.c ?? 3`, 3},
+		{`{a: 1}.a ?? 2`, 1},
+		{`[1, 2][0] ?? 3`, 1},
+		{`nil ?? nil ?? 4`, 4},
+		{`Any ?? 0 == 0 ? "yes" : "no"`, "yes"},
+	}
+
+	env := &mockEnv{}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		output, err := vm.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.output, output, test.input)
+	}
+
+	env.Ticket = &mockTicket{Price: 100}
+
+	tree, err := parser.Parse(`Ticket?.Price + 1`)
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	output, err := vm.Run(program, env, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 101, output)
+}
+
+func TestRun_nil_map(t *testing.T) {
+	type test struct {
+		input  string
+		output interface{}
+	}
+	var tests = []test{
+		{`limit ?? 10`, 10},
+		{`limit.max ?? 10`, 10},
+		{`limit?.max ?? 10`, 10},
+		{`limit[0] ?? 10`, 10},
+		{`user.name ?? "anonymous"`, "Arthur"},
+		{`user.nickname ?? user.name`, "Arthur"},
+		{`let limit = 5; limit ?? 10`, 5},
+	}
+
+	env := map[string]interface{}{
+		"user": map[string]interface{}{"name": "Arthur"},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		output, err := vm.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+
+		program, err = compiler.Compile(tree, compiler.MapEnv())
+		require.NoError(t, err, test.input)
+
+		output, err = vm.Run(program, env, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, output, test.input)
+	}
+
+	tree, err := parser.Parse(`limit + 1`)
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	_, err = vm.Run(program, env, nil)
+	require.Error(t, err)
+}
+
+func TestRun_constant_copy(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output interface{}
+		modify func(out interface{})
+	}{
+		{
+			`[1, [2, 3]]`,
+			[]interface{}{1, []interface{}{2, 3}},
+			func(out interface{}) { out.([]interface{})[1].([]interface{})[0] = 0 },
+		},
+		{
+			`{a: [1], b: {c: 2}}`,
+			map[string]interface{}{"a": []interface{}{1}, "b": map[string]interface{}{"c": 2}},
+			func(out interface{}) {
+				m := out.(map[string]interface{})
+				m["a"].([]interface{})[0] = 0
+				m["b"].(map[string]interface{})["c"] = 0
+				delete(m, "b")
+			},
+		},
+		{
+			`1..3`,
+			[]interface{}{1, 2, 3},
+			func(out interface{}) { out.([]interface{})[0] = 0 },
+		},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		out, err := vm.Run(program, nil, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, out, test.input)
+
+		// Modified result must not change results of next runs.
+		test.modify(out)
+
+		out, err = vm.Run(program, nil, nil)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.output, out, test.input)
+	}
+}
+
+func TestRun_error(t *testing.T) {
+	type test struct {
+		input   string
+		kind    vm.ErrorKind
+		message string
+	}
+	var tests = []test{
+		{
+			`1 / Int`,
+			vm.DivisionByZero,
+			"runtime error: integer divide by zero (1:3)\n | 1 / Int\n | ..^",
+		},
+		{
+			`Array[10]`,
+			vm.IndexOutOfRange,
+			"index out of range [10] with length 5 (1:1)\n | Array[10]\n | ^",
+		},
+		{
+			`Any + 1`,
+			vm.TypeMismatch,
+			"invalid operation: string + int (1:5)\n | Any + 1\n | ....^",
+		},
+		{
+			`{a: 1}.b`,
+			vm.FieldNotFound,
+			"map[a:1] doesn't contains b (1:1)\n | {a: 1}.b\n | ^",
+		},
+		{
+			`Unknown()`,
+			vm.FunctionNotFound,
+			"can't get \"Unknown\" from *vm_test.mockEnv (1:1)\n | Unknown()\n | ^",
+		},
+		{
+			`Ticket.Price`,
+			vm.NilDereference,
+			"cannot fetch Price from nil *vm_test.mockTicket (1:1)\n | Ticket.Price\n | ^",
+		},
+		{
+			`String matches ("(" + String)`,
+			vm.InvalidRegexp,
+			"error parsing regexp: missing closing ): `(string` (1:1)\n | String matches (\"(\" + String)\n | ^",
+		},
+		{
+			`Fold(Array, 0, (acc, x) => acc + x * 0.5)`,
+			vm.TypeMismatch,
+			"cannot use float64 as int in return (1:1)\n | Fold(Array, 0, (acc, x) => acc + x * 0.5)\n | ^",
+		},
+		{
+			`min([])`,
+			vm.EmptyArray,
+			"min of empty array (1:1)\n | min([])\n | ^",
+		},
+		{
+			`1 + max(Array, {#}) + max(filter(Array, {# > 5}), {#})`,
+			vm.EmptyArray,
+			"max of empty array (1:23)\n | 1 + max(Array, {#}) + max(filter(Array, {# > 5}), {#})\n | ......................^",
+		},
+		{
+			`avg(filter(Array, {# > 5}))`,
+			vm.EmptyArray,
+			"avg of empty array (1:1)\n | avg(filter(Array, {# > 5}))\n | ^",
+		},
+	}
+
+	env := &mockEnv{
+		Any:    "any",
+		String: "string",
+		Array:  []int{1, 2, 3, 4, 5},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		_, err = vm.Run(program, env, nil)
+		require.Error(t, err, test.input)
+
+		runtimeErr, ok := err.(*vm.RuntimeError)
+		require.True(t, ok, test.input)
+		assert.Equal(t, test.kind, runtimeErr.Kind, test.input)
+		assert.Equal(t, test.message, err.Error(), test.input)
+	}
+}
+
+func TestRun_context(t *testing.T) {
+	var input = `map(1..1000, {# * 2})`
+
+	tree, err := parser.Parse(input)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, nil)
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = vm.Run(program, nil, ctx)
+	require.Error(t, err)
+	ctxErr, ok := err.(*vm.ContextError)
+	require.True(t, ok)
+	assert.Equal(t, context.Canceled, ctxErr.Err)
+	assert.Equal(t, 1, ctxErr.Location.Line())
+	assert.Equal(t, "context canceled (1:6)\n | map(1..1000, {# * 2})\n | .....^", err.Error())
+}
+
+func TestRun_context_deadline(t *testing.T) {
+	var input = `all(1..1000, {Sleep() > 0})`
+
+	tree, err := parser.Parse(input)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = vm.Run(program, &mockEnv{}, ctx)
+	require.Error(t, err)
+	ctxErr, ok := err.(*vm.ContextError)
+	require.True(t, ok)
+	assert.Equal(t, context.DeadlineExceeded, ctxErr.Err)
+}
+
+func TestRun_budget(t *testing.T) {
+	type test struct {
+		input  string
+		option vm.RunOption
+		limit  vm.Limit
+	}
+	var tests = []test{
+		{
+			`map(1..100, {# * 2})`,
+			vm.MaxInstructions(100),
+			vm.InstructionsLimit,
+		},
+		{
+			`[Int, Int, Int, Int, Int]`,
+			vm.MaxStackSize(3),
+			vm.StackSizeLimit,
+		},
+		{
+			`Int..99999999`,
+			vm.MaxRangeSize(1000),
+			vm.RangeSizeLimit,
+		},
+		{
+			`map(0..999, {0..#})`,
+			vm.MaxAllocations(10000),
+			vm.AllocationsLimit,
+		},
+		{
+			`{a: Int, b: Int}`,
+			vm.MaxAllocations(1),
+			vm.AllocationsLimit,
+		},
+		{
+			`len(map(1..1000, {1..60000}))`,
+			vm.MaxAllocations(10000),
+			vm.AllocationsLimit,
+		},
+		{
+			`split(repeat("a,", 100), ",")`,
+			vm.MaxAllocations(100),
+			vm.AllocationsLimit,
+		},
+		{
+			`Apply(1, {len(map(1..100, {#}))})`,
+			vm.MaxInstructions(1000),
+			vm.InstructionsLimit,
+		},
+	}
+
+	for _, test := range tests {
+		tree, err := parser.Parse(test.input)
+		require.NoError(t, err, test.input)
+
+		_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+		require.NoError(t, err, test.input)
+
+		program, err := compiler.Compile(tree)
+		require.NoError(t, err, test.input)
+
+		_, err = vm.Run(program, &mockEnv{}, nil, test.option)
+		require.Error(t, err, test.input)
+
+		budgetErr, ok := err.(*vm.BudgetExceeded)
+		require.True(t, ok, test.input)
+		assert.Equal(t, test.limit, budgetErr.Limit, test.input)
+	}
+}
+
+func TestRun_budget_enough(t *testing.T) {
+	tree, err := parser.Parse(`filter(1..9, {# > 7})`)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, nil)
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	out, err := vm.Run(program, nil, nil, vm.MaxInstructions(1000), vm.MaxStackSize(10), vm.MaxAllocations(11))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{8, 9}, out)
+}
+
+func TestVM_RunProgram(t *testing.T) {
+	env := map[string]interface{}{
+		"Origin": "MOW",
+		"Value":  int64(100),
+	}
+
+	tree, err := parser.Parse(`Origin == "MOW" && Value >= 100`)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(env))
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree, compiler.MapEnv())
+	require.NoError(t, err)
+
+	v := vm.NewVM(false, nil)
+	for i := 0; i < 3; i++ {
+		out, err := v.RunProgram(program, env)
+		require.NoError(t, err)
+		assert.Equal(t, true, out)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = v.RunProgram(program, env)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestVM_Reset(t *testing.T) {
+	tree, err := parser.Parse(`all(1..3, {# > 0}) && Int64 == 0`)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	v := vm.NewVM(false, nil)
+
+	// Failed run leaves garbage on the stack.
+	_, err = v.RunProgram(program, nil)
+	require.Error(t, err)
+
+	out, err := v.RunProgram(program, &mockEnv{})
+	require.NoError(t, err)
+	assert.Equal(t, true, out)
+	assert.Len(t, v.Stack(), 0)
+
+	// Program is cleared as well, so nothing is evaluated.
+	v.Reset()
+	out, err = v.Run()
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestRun_closure_escaped(t *testing.T) {
+	tree, err := parser.Parse(`Keep({# * 2}) && Apply(1, {# + 1}) == 2`)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	env := &mockEnv{}
+	out, err := vm.Run(program, env, nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, out)
+
+	// Closure must not be called after the run, even if VM is reused.
+	for i := 0; i < 3; i++ {
+		_, err = vm.Run(program, &mockEnv{}, nil)
+		require.NoError(t, err)
+	}
+
+	defer func() {
+		r := recover()
+		require.IsType(t, &vm.RuntimeError{}, r)
+		assert.Contains(t, r.(error).Error(), "closure called after the program has finished")
+	}()
+	env.Kept(1)
+}
+
+func TestProgram_MarshalBinary(t *testing.T) {
+	input := `[Add(100000, Int), 1.5, String matches "^str", 2 in 1..3, Ticket.PriceDiv(2), String + "b", {a: [1, 2]}, String in ["x", "string"], 1h30m]`
+	output := []interface{}{100005, 1.5, true, true, 5, "stringb", map[string]interface{}{"a": []interface{}{1, 2}}, true, 90 * time.Minute}
+
+	env := &mockEnv{
+		Int:    5,
+		String: "string",
+		Ticket: &mockTicket{Price: 10},
+	}
+
+	tree, err := parser.Parse(input)
+	require.NoError(t, err)
+
+	_, err = checker.Check(tree, checker.Env(&mockEnv{}))
+	require.NoError(t, err)
+
+	program, err := compiler.Compile(tree)
+	require.NoError(t, err)
+
+	b, err := program.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded := &vm.Program{}
+	err = decoded.UnmarshalBinary(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, program.Disassemble(), decoded.Disassemble())
+	assert.Equal(t, program.Constants, decoded.Constants)
+	assert.Equal(t, program.Locations, decoded.Locations)
+	assert.Equal(t, program.Source.Content(), decoded.Source.Content())
+
+	out, err := vm.Run(decoded, env, nil)
+	require.NoError(t, err)
+	assert.Equal(t, output, out)
+
+	j, err := json.Marshal(program)
+	require.NoError(t, err)
+
+	decoded = &vm.Program{}
+	err = json.Unmarshal(j, decoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, program.Disassemble(), decoded.Disassemble())
+	assert.Equal(t, program.Constants, decoded.Constants)
+	assert.Equal(t, program.Locations, decoded.Locations)
+
+	out, err = vm.Run(decoded, env, nil)
+	require.NoError(t, err)
+	assert.Equal(t, output, out)
+}
+
+func TestProgram_MarshalJSON_nonFinite(t *testing.T) {
+	program := &vm.Program{
+		Constants: []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), float32(math.Inf(-1)), []interface{}{math.Inf(1), 1.5}},
+		Bytecode:  []byte{vm.OpConst, 0, 0},
+	}
+
+	j, err := json.Marshal(program)
+	require.NoError(t, err)
+	assert.Contains(t, string(j), `{"kind":"float64","value":"NaN"},{"kind":"float64","value":"+Inf"},{"kind":"float64","value":"-Inf"},{"kind":"float32","value":"-Inf"}`)
+
+	decoded := &vm.Program{}
+	require.NoError(t, json.Unmarshal(j, decoded))
+
+	assert.True(t, math.IsNaN(decoded.Constants[0].(float64)))
+	assert.Equal(t, program.Constants[1:], decoded.Constants[1:])
+
+	j = []byte(strings.Replace(string(j), `"+Inf"`, `"Inf"`, 1))
+	err = json.Unmarshal(j, &vm.Program{})
+	assert.EqualError(t, err, `invalid program encoding: invalid float "Inf"`)
+}
+
+func TestProgram_UnmarshalBinary_error(t *testing.T) {
+	program := &vm.Program{
+		Constants: []interface{}{"foo"},
+		Bytecode:  []byte{vm.OpFetch, 0, 0},
+	}
+
+	b, err := program.MarshalBinary()
+	require.NoError(t, err)
+
+	corrupted := append([]byte{}, b...)
+	corrupted[len(corrupted)-6]++
+	err = (&vm.Program{}).UnmarshalBinary(corrupted)
+	assert.EqualError(t, err, "invalid program encoding: checksum mismatch")
+
+	err = (&vm.Program{}).UnmarshalBinary(b[:len(b)-1])
+	assert.Error(t, err)
+
+	err = (&vm.Program{}).UnmarshalBinary([]byte("{}"))
+	assert.EqualError(t, err, "invalid program encoding")
+
+	j, err := json.Marshal(program)
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(j, &fields))
+	fields["version"] = vm.EncodingVersion + 1
+	j, err = json.Marshal(fields)
+	require.NoError(t, err)
+
+	err = json.Unmarshal(j, &vm.Program{})
+	assert.EqualError(t, err, fmt.Sprintf("unsupported program encoding version %v (expected %v)", vm.EncodingVersion+1, vm.EncodingVersion))
+
+	program.Bytecode = []byte{vm.OpConst, 0, 0}
+	_, err = program.MarshalBinary()
+	require.NoError(t, err)
+
+	program.Constants = []interface{}{struct{}{}}
+	_, err = program.MarshalBinary()
+	assert.EqualError(t, err, "can't encode constant of type struct {}")
+
+	program.Constants = []interface{}{vm.BuiltinCall{Name: "unknown"}}
+	program.Bytecode = []byte{vm.OpBuiltin, 0, 0}
+	b, err = program.MarshalBinary()
+	require.NoError(t, err)
+
+	err = (&vm.Program{}).UnmarshalBinary(b)
+	assert.EqualError(t, err, "invalid program encoding: unknown builtin unknown")
+
+	j, err = json.Marshal(program)
+	require.NoError(t, err)
+
+	err = json.Unmarshal(j, &vm.Program{})
+	assert.EqualError(t, err, "invalid program encoding: unknown builtin unknown")
+}
+
 type mockEnv struct {
 	Any      interface{}
 	Int      int
@@ -286,8 +1174,12 @@ type mockEnv struct {
 	Ticket   *mockTicket
 	BirthDay time.Time
 	Now      time.Time
+	Kept     func(float64) float64
+	Level    mockLevel
 }
 
+type mockLevel int
+
 func (e *mockEnv) GetInt() int {
 	return e.Int
 }
@@ -304,6 +1196,35 @@ func (*mockEnv) Duration(s string) time.Duration {
 	return d
 }
 
+func (*mockEnv) SortBy(items []int, key func(int) interface{}) []int {
+	sorted := append([]int{}, items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return key(sorted[i]).(int) < key(sorted[j]).(int)
+	})
+	return sorted
+}
+
+func (*mockEnv) Fold(items []int, acc int, fn func(acc, item int) int) int {
+	for _, item := range items {
+		acc = fn(acc, item)
+	}
+	return acc
+}
+
+func (*mockEnv) Apply(x float64, fn func(float64) float64) float64 {
+	return fn(x)
+}
+
+func (e *mockEnv) Keep(fn func(float64) float64) bool {
+	e.Kept = fn
+	return true
+}
+
+func (*mockEnv) Sleep() int {
+	time.Sleep(time.Millisecond)
+	return 1
+}
+
 type mockTicket struct {
 	Price int
 }
@@ -312,6 +1233,10 @@ func (t *mockTicket) PriceDiv(p int) int {
 	return t.Price / p
 }
 
+func (t *mockTicket) Apply(fn func(*mockTicket) int) int {
+	return fn(t)
+}
+
 func (t *mockTicket) String() string {
 	return fmt.Sprintf("$%v", t.Price)
 }